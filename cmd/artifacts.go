@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+var artifactsOutput = "-"
+
+// artifactsCmd is a meta command to group all artifact commands together.
+var artifactsCmd = &cobra.Command{
+	Use:   "artifacts",
+	Short: "Manage artifacts collected from jobs",
+}
+
+// artifactsGetCmd downloads the artifacts of a terminated job as a tar archive.
+var artifactsGetCmd = &cobra.Command{
+	Use:   "get <job_id>",
+	Short: "Download the artifacts of a terminated job as a tar archive",
+	Long: `Download the artifacts of a terminated job as a tar archive.
+
+Artifacts are collected from files matching run --artifact once the job terminates.
+For example: artifacts get users/bob/jobs/<uid> | tar -x`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, err := foremanClient.DownloadArtifacts(cmd.Context(), &foremanpb.DownloadArtifactsRequest{Name: args[0]})
+		if err != nil {
+			return err
+		}
+
+		var w io.Writer = os.Stdout
+		if artifactsOutput != "-" {
+			f, err := os.Create(artifactsOutput)
+			if err != nil {
+				return fmt.Errorf("failed to create output: %w", err)
+			}
+			defer f.Close()
+			w = f
+		}
+
+		var n int64
+		for {
+			chunk, err := s.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if _, err := w.Write(chunk.GetData()); err != nil {
+				return fmt.Errorf("failed to write artifacts: %w", err)
+			}
+			n += int64(len(chunk.GetData()))
+		}
+
+		if artifactsOutput != "-" {
+			slog.Info("Downloaded artifacts", slog.String("name", args[0]), slog.String("file", artifactsOutput), slog.Int64("bytes", n))
+		}
+		return nil
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(artifactsCmd)
+	artifactsCmd.AddCommand(artifactsGetCmd)
+	artifactsGetCmd.Flags().StringVarP(&artifactsOutput, "output", "o", artifactsOutput, "file to write the tar archive to, or - for standard output")
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffExport = false
+	diffOutput = "-"
+)
+
+// diffKinds abbreviates each kind of change, like docker diff.
+var diffKinds = map[foremanpb.FileChangeKind]string{
+	foremanpb.FileChangeKind_FILE_CHANGE_KIND_ADDED:    "A",
+	foremanpb.FileChangeKind_FILE_CHANGE_KIND_MODIFIED: "C",
+	foremanpb.FileChangeKind_FILE_CHANGE_KIND_DELETED:  "D",
+}
+
+// diffCmd lists, or exports, the files changed by a terminated job in its overlays.
+var diffCmd = &cobra.Command{
+	Use:   "diff <job_id>",
+	Short: "List the files changed by a terminated job in its overlays",
+	Long: `List the files changed by a terminated job in its overlays, see run --overlay.
+
+Each path is prefixed by A if it was added, C if it was changed, or D if it was deleted.
+With --export, the changes are written as a tar archive in the OCI image layer format instead.
+For example: diff --export users/bob/jobs/<uid> -o changes.tar`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, err := foremanClient.GetJobFilesystemDiff(cmd.Context(), &foremanpb.GetJobFilesystemDiffRequest{Name: args[0], Export: diffExport})
+		if err != nil {
+			return err
+		}
+
+		var w io.Writer = os.Stdout
+		if diffOutput != "-" {
+			f, err := os.Create(diffOutput)
+			if err != nil {
+				return fmt.Errorf("failed to create output: %w", err)
+			}
+			defer f.Close()
+			w = f
+		}
+
+		var n int64
+		for {
+			diff, err := s.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			for _, c := range diff.GetChanges() {
+				if _, err := fmt.Fprintf(w, "%s %s\n", diffKinds[c.GetKind()], c.GetPath()); err != nil {
+					return fmt.Errorf("failed to write diff: %w", err)
+				}
+			}
+			if _, err := w.Write(diff.GetData()); err != nil {
+				return fmt.Errorf("failed to write diff: %w", err)
+			}
+			n += int64(len(diff.GetData()))
+		}
+
+		if diffExport && diffOutput != "-" {
+			slog.Info("Exported diff", slog.String("name", args[0]), slog.String("file", diffOutput), slog.Int64("bytes", n))
+		}
+		return nil
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(diffCmd)
+	diffCmd.Flags().BoolVar(&diffExport, "export", diffExport, "write the changes as a tar archive instead of listing them")
+	diffCmd.Flags().StringVarP(&diffOutput, "output", "o", diffOutput, "file to write to, or - for standard output")
+}
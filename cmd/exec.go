@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	execTTY bool
+	execEnv map[string]string
+)
+
+// execCmd runs an additional command in the sandbox of a running job.
+var execCmd = &cobra.Command{
+	SilenceUsage: true,
+	Use:          "exec <job_id> [-t] -- <command> [args...]",
+	Short:        "Run a command inside the sandbox of a running job",
+	Long: `Run a command inside the sandbox of a running job.
+
+The command joins the PID, mount, and UTS namespaces and the cgroup of the job,
+so it sees the same processes, hostname, and working directory as the job.
+Standard input is forwarded to the command, and this command exits with its exit code.
+
+Use -t to run an interactive shell with a terminal.
+For example: exec users/bob/jobs/<uid> -t -- /bin/sh`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, err := foremanClient.ExecInJob(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		// requests are sent from several goroutines
+		var mu sync.Mutex
+		send := func(req *foremanpb.ExecInJobRequest) error {
+			mu.Lock()
+			defer mu.Unlock()
+			return s.Send(req)
+		}
+
+		name := args[0]
+		req := &foremanpb.ExecInJobRequest{
+			Name:    name,
+			Command: args[1],
+			Args:    args[2:],
+			Env:     execEnv,
+			Tty:     execTTY,
+		}
+
+		if execTTY {
+			size, err := terminalSize()
+			if err != nil {
+				return fmt.Errorf("-t requires a terminal: %w", err)
+			}
+			req.TerminalSize = size
+
+			restore, err := makeRaw(int(os.Stdin.Fd()))
+			if err != nil {
+				return fmt.Errorf("failed to configure terminal: %w", err)
+			}
+			defer restore()
+
+			// forward resizes of the local terminal
+			winch := make(chan os.Signal, 1)
+			signal.Notify(winch, syscall.SIGWINCH)
+			defer signal.Stop(winch)
+			go func() {
+				for range winch {
+					if size, err := terminalSize(); err == nil {
+						_ = send(&foremanpb.ExecInJobRequest{Name: name, TerminalSize: size})
+					}
+				}
+			}()
+		}
+
+		if err := send(req); err != nil {
+			return err
+		}
+
+		go func() {
+			buf := make([]byte, 10240)
+			for {
+				n, err := os.Stdin.Read(buf)
+				if n > 0 {
+					if err := send(&foremanpb.ExecInJobRequest{Name: name, Stdin: append([]byte{}, buf[:n]...)}); err != nil {
+						return
+					}
+				}
+				if err != nil {
+					_ = send(&foremanpb.ExecInJobRequest{Name: name, CloseStdin: true})
+					return
+				}
+			}
+		}()
+
+		for {
+			resp, err := s.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return errors.New("exec ended without an exit code")
+				}
+				return err
+			}
+
+			if resp.GetExited() {
+				if code := resp.GetExitCode(); code != 0 {
+					return &exitError{code: int(code)}
+				}
+				return nil
+			}
+
+			if resp.GetStream() == foremanpb.OutputStream_OUTPUT_STREAM_STDERR {
+				os.Stderr.Write(resp.GetData())
+			} else {
+				os.Stdout.Write(resp.GetData())
+			}
+		}
+	},
+}
+
+// exitError is returned by a command that exits with the exit code of a remote process.
+type exitError struct {
+	code int
+}
+
+// Error implements error.
+func (e *exitError) Error() string {
+	return fmt.Sprintf("command terminated with exit code %d", e.code)
+}
+
+// terminalSize returns the size of the terminal attached to standard output.
+func terminalSize() (*foremanpb.TerminalSize, error) {
+	ws, err := unix.IoctlGetWinsize(int(os.Stdout.Fd()), unix.TIOCGWINSZ)
+	if err != nil {
+		return nil, err
+	}
+	return &foremanpb.TerminalSize{Rows: uint32(ws.Row), Cols: uint32(ws.Col)}, nil
+}
+
+// makeRaw puts the terminal fd into raw mode, so every key is sent to the remote terminal.
+// The returned function restores the previous mode.
+func makeRaw(fd int) (restore func(), err error) {
+	prev, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := *prev
+	raw.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	raw.Oflag &^= unix.OPOST
+	raw.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	raw.Cflag &^= unix.CSIZE | unix.PARENB
+	raw.Cflag |= unix.CS8
+	raw.Cc[unix.VMIN] = 1
+	raw.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(fd, unix.TCSETS, &raw); err != nil {
+		return nil, err
+	}
+
+	return func() { _ = unix.IoctlSetTermios(fd, unix.TCSETS, prev) }, nil
+}
+
+func init() {
+	clientCmd.AddCommand(execCmd)
+	execCmd.Flags().SetInterspersed(true)
+	execCmd.Flags().BoolVarP(&execTTY, "tty", "t", execTTY, "run the command with a terminal, for interactive shells")
+	execCmd.Flags().StringToStringVar(&execEnv, "env", execEnv, "additional environment variables, such as LEVEL=debug (repeatable)")
+}
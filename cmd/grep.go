@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+var (
+	grepLiteral     bool
+	grepIgnoreCase  bool
+	grepBefore      int32
+	grepAfter       int32
+	grepContext     int32
+	grepMaxMatches  int32
+	grepLineNumbers bool
+	grepByteOffsets bool
+)
+
+// grepCmd searches the output of a job on the Telehandler server.
+var grepCmd = &cobra.Command{
+	Use:   "grep <job_id> <pattern>",
+	Short: "Search the output of a job for lines matching a pattern",
+	Long: `Search the output of a job for lines matching a pattern.
+
+The search runs on the server, so only matching lines and their context are downloaded.
+Patterns use RE2 syntax, see https://github.com/google/re2/wiki/Syntax.
+For example: grep users/bob/jobs/<uid> -i -C 2 'error|panic'`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &foremanpb.SearchJobOutputRequest{
+			Name:          args[0],
+			Pattern:       args[1],
+			Literal:       grepLiteral,
+			IgnoreCase:    grepIgnoreCase,
+			BeforeContext: grepBefore,
+			AfterContext:  grepAfter,
+			MaxMatches:    grepMaxMatches,
+		}
+		if cmd.Flags().Changed("context") {
+			req.BeforeContext, req.AfterContext = grepContext, grepContext
+		}
+
+		s, err := foremanClient.SearchJobOutput(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		// the last line printed, so overlapping context is only printed once
+		var last int64
+		for {
+			m, err := s.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+
+			lines := append(append(m.GetBefore(), m.GetLine()), m.GetAfter()...)
+			if last > 0 && (req.GetBeforeContext() > 0 || req.GetAfterContext() > 0) && lines[0].GetLineNumber() > last+1 {
+				fmt.Println("--")
+			}
+			for _, l := range lines {
+				if l.GetLineNumber() <= last {
+					continue
+				}
+				sep := "-"
+				if l == m.GetLine() {
+					sep = ":"
+				}
+				printOutputLine(l, sep)
+				last = l.GetLineNumber()
+			}
+		}
+	},
+}
+
+// printOutputLine prints a single line like grep, where sep is ':' for matches and '-' for context.
+func printOutputLine(l *foremanpb.OutputLine, sep string) {
+	var prefix strings.Builder
+	if grepLineNumbers {
+		fmt.Fprintf(&prefix, "%d%s", l.GetLineNumber(), sep)
+	}
+	if grepByteOffsets {
+		fmt.Fprintf(&prefix, "%d%s", l.GetOffset(), sep)
+	}
+	fmt.Printf("%s%s\n", prefix.String(), l.GetData())
+}
+
+func init() {
+	clientCmd.AddCommand(grepCmd)
+	grepCmd.Flags().BoolVarP(&grepLiteral, "fixed-strings", "F", grepLiteral, "match the pattern as a literal string")
+	grepCmd.Flags().BoolVarP(&grepIgnoreCase, "ignore-case", "i", grepIgnoreCase, "match the pattern without regard to case")
+	grepCmd.Flags().Int32VarP(&grepBefore, "before-context", "B", grepBefore, "number of lines to print before each match")
+	grepCmd.Flags().Int32VarP(&grepAfter, "after-context", "A", grepAfter, "number of lines to print after each match")
+	grepCmd.Flags().Int32VarP(&grepContext, "context", "C", grepContext, "number of lines to print before and after each match")
+	grepCmd.Flags().Int32VarP(&grepMaxMatches, "max-count", "m", grepMaxMatches, "stop after this many matches (0 is the server default)")
+	grepCmd.Flags().BoolVarP(&grepLineNumbers, "line-number", "n", grepLineNumbers, "prefix each line with its line number")
+	grepCmd.Flags().BoolVarP(&grepByteOffsets, "byte-offset", "b", grepByteOffsets, "prefix each line with its byte offset in the job output")
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/image"
+	"github.com/spf13/cobra"
+)
+
+// imageCmd is a meta command to group all image commands together.
+// Images are managed on the server host, in the image store of the server.
+var imageCmd = &cobra.Command{
+	Use:   "image",
+	Short: "Manage the images that jobs can run in, on the server host",
+	Long: `Manage the images that jobs can run in, on the server host.
+
+Images are kept in <work-root>/images, and are available to a running server
+as soon as they are imported. Jobs select an image with client run --image.`,
+}
+
+// imageImportCmd imports an OCI image layout tarball.
+var imageImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import the images of an OCI image layout tarball",
+	Long: `Import the images of an OCI image layout tarball, or - for standard input.
+
+Tarballs are written offline by docker save, with the containerd image store, or by
+skopeo copy docker://alpine:3.20 oci-archive:alpine.tar:alpine:3.20. Each image is
+named after its reference in the tarball, and replaces any image with the same name.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := image.NewStore(filepath.Join(workRoot, "images"))
+		if err != nil {
+			return err
+		}
+
+		var r io.Reader = os.Stdin
+		if args[0] != "-" {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open image: %w", err)
+			}
+			defer f.Close()
+			r = f
+		}
+
+		_, err = store.Import(r)
+		return err
+	},
+}
+
+// imageListCmd lists the imported images.
+var imageListCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List the imported images",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		store, err := image.NewStore(filepath.Join(workRoot, "images"))
+		if err != nil {
+			return err
+		}
+		images, err := store.List()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tDIGEST\tLAYERS\tIMPORTED\tCOMMAND")
+		for _, img := range images {
+			command, args, _ := img.Command(nil)
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+				img.Name,
+				img.Digest,
+				len(img.Layers),
+				img.ImportTime.Format(time.RFC3339),
+				strings.Join(append([]string{command}, args...), " "),
+			)
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(imageCmd)
+	imageCmd.AddCommand(imageImportCmd, imageListCmd)
+	imageCmd.PersistentFlags().StringVar(&workRoot, "work-root", workRoot, "work root of the server, which holds the image store")
+}
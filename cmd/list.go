@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"path"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+var listSelector = ""
+
+// listCmd lists jobs on the Telehandler server, optionally filtered by labels.
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List jobs, optionally filtered by a label selector",
+	Long: `List jobs, optionally filtered by a label selector.
+
+Selectors are comma-separated requirements that must all match. For example:
+  list -l team=infra,env in (dev,staging),!canary`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		resp, err := foremanClient.ListJobs(cmd.Context(), &foremanpb.ListJobsRequest{
+			Parent:        path.Join("users/", userName),
+			LabelSelector: listSelector,
+		})
+		if err != nil {
+			return err
+		}
+		for _, j := range resp.GetJobs() {
+			printJobStatus(j)
+		}
+		return nil
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(listCmd)
+	listCmd.Flags().StringVarP(&listSelector, "selector", "l", listSelector, "label selector, such as team=infra,!canary")
+}
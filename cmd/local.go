@@ -20,7 +20,7 @@ are executed in the local environment.
 `,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		mgr := work.NewExecutor(cgroupRoot)
+		mgr := work.NewExecutor(work.Settings{CgroupRoot: cgroupRoot})
 
 		job := *work.NewJob("admin", args[0], args[1:])
 		job, err := mgr.Start(job)
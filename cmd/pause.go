@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+// pauseCmd freezes all processes of a running job.
+var pauseCmd = &cobra.Command{
+	Use:   "pause <job_id>",
+	Short: "Pause a running job by freezing all of its processes",
+	Long: `Pause a running job by freezing all of its processes.
+
+The job keeps its memory and capacity, but uses no CPU until it is resumed.
+Time spent paused does not count toward the job timeout.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		status, err := foremanClient.PauseJob(cmd.Context(), &foremanpb.PauseJobRequest{Name: args[0]})
+		if err != nil {
+			return err
+		}
+		printJobStatus(status)
+		return nil
+	},
+}
+
+// resumeCmd thaws all processes of a paused job.
+var resumeCmd = &cobra.Command{
+	Use:   "resume <job_id>",
+	Short: "Resume a paused job where it left off",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		status, err := foremanClient.ResumeJob(cmd.Context(), &foremanpb.ResumeJobRequest{Name: args[0]})
+		if err != nil {
+			return err
+		}
+		printJobStatus(status)
+		return nil
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(pauseCmd)
+	clientCmd.AddCommand(resumeCmd)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+// psCmd lists the processes of a running job.
+var psCmd = &cobra.Command{
+	Use:   "ps <job_id>",
+	Short: "List the processes of a running job",
+	Long: `List the processes of a running job, including commands run with exec.
+
+PID and PPID are process IDs on the host, and NSPID is the process ID inside the job.
+RSS is the resident set size, and TIME is the total CPU time of each process.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := foremanClient.ListJobProcesses(cmd.Context(), &foremanpb.ListJobProcessesRequest{Name: args[0]})
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "PID\tNSPID\tPPID\tSTAT\tRSS\tTIME\tCOMMAND")
+		for _, p := range resp.GetProcesses() {
+			fmt.Fprintf(w, "%d\t%d\t%d\t%s\t%s\t%s\t%s\n",
+				p.GetPid(),
+				p.GetNamespacePid(),
+				p.GetParentPid(),
+				p.GetState(),
+				formatBytes(p.GetRssBytes()),
+				p.GetCpuTime().AsDuration().Round(10*time.Millisecond),
+				strings.Join(p.GetArgs(), " "),
+			)
+		}
+		return w.Flush()
+	},
+}
+
+// formatBytes formats n as a human-readable size in binary units, such as 1.5M.
+func formatBytes(n int64) string {
+	const units = "KMGT"
+	if n < 1024 {
+		return fmt.Sprintf("%dB", n)
+	}
+	v, i := float64(n)/1024, 0
+	for v >= 1024 && i < len(units)-1 {
+		v /= 1024
+		i++
+	}
+	return fmt.Sprintf("%.1f%c", v, units[i])
+}
+
+func init() {
+	clientCmd.AddCommand(psCmd)
+}
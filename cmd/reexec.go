@@ -1,9 +1,6 @@
 package cmd
 
 import (
-	"os/signal"
-	"syscall"
-
 	"github.com/drrev/telehandler/pkg/work"
 	"github.com/spf13/cobra"
 )
@@ -11,29 +8,30 @@ import (
 // reexecCmd is used to wrap the execution of a child process
 // to configure cgroup and namespaces.
 // This command should only be called by [work.Executor] as
-// part of the [work.Job] execution process.
+// part of the [work.Job] execution process, which passes
+// the runtime spec of the sandbox over a file descriptor.
 var reexecCmd = &cobra.Command{
 	Hidden:       true,
 	SilenceUsage: true,
 	Use:          "reexec",
+	Args:         cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := cmd.Context()
-
-		// intercept signals for graceful shutdown
-		basectx, cancel := signal.NotifyContext(
-			ctx,
-			syscall.SIGHUP,
-			syscall.SIGINT,
-			syscall.SIGQUIT,
-			syscall.SIGTERM,
-		)
-		defer cancel()
+		// setup errors are reported to the Executor, and the exit status is
+		// the exact status of the job, so nothing is written to its output
+		cmd.SilenceErrors = true
 
-		return work.Reexec(basectx, cgroupRoot, args)
+		// signals are forwarded to the job by the init, not handled here
+		code, err := work.Reexec(cmd.Context())
+		if err != nil {
+			return err
+		}
+		if code != 0 {
+			return &exitError{code: code}
+		}
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(reexecCmd)
-	reexecCmd.Flags().SetInterspersed(true)
 }
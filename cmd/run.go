@@ -1,32 +1,173 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
+	"time"
 
 	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/work"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+var (
+	runPriority    int32
+	runRestart     = "never"
+	runMaxAttempts int32
+	runLabels      map[string]string
+	runAnnotations map[string]string
+	runRequestID   = ""
+	runJobID       = ""
+	runEnv         map[string]string
+	runCPU         int64
+	runMemoryMiB   int64
+	runTimeout     time.Duration
+	runTemplate    = ""
+	runParams      map[string]string
+	runFiles       []string
+	runStdin       = ""
+	runArtifacts   []string
+	runSeccomp     = ""
+	runCapAdd      []string
+	runCapDrop     []string
+	runUser        uint32
+	runReadOnly    []string
+	runReadWrite   []string
+	runMounts      []string
+	runOverlays    []string
+	runImage       = ""
+	runBundle      = ""
+	runNamespaces  []string
+	runHostname    = ""
+)
+
+// uploadChunkSize is the maximum number of bytes sent in each upload message.
+const uploadChunkSize = 64 << 10
+
 // runCmd executes the given command using a Telehandler server.
 // To simplify usage, this command automatically streams Job output.
 var runCmd = &cobra.Command{
-	Use:   "run <command> [args...]",
+	Use:   "run <command> [args...] | --template <template_id> | --image <image> [command] [args...] | --bundle <dir>",
 	Short: "Run a Linux command using a Telehandler server",
 	Long: `Run a Linux command using a Telehandler server.
 	
 Commands that include args should signal the end of args for this command with --
-For example: run -- bash -c echo hello"`,
-	Args: cobra.MinimumNArgs(1),
+For example: run -- bash -c echo hello"
+
+To run a job template instead, pass its name and parameter values.
+For example: run --template users/admin/jobTemplates/<uid> -p target=/srv -p retries=5
+
+To run within the filesystem of an image imported on the server, pass its name.
+The command of the image is run, unless a command is given.
+For example: run --image docker.io/library/alpine:3.20 -- sh -c 'cat /etc/os-release'
+
+To run the process of an OCI runtime bundle, pass its directory. Only its config.json
+is sent, and fields that are not supported are rejected, including root, so the rootfs
+of the bundle is not used. Combine it with --image to run in a root filesystem.
+For example: run --bundle ./build --image docker.io/library/golang:1.23
+
+Local files are uploaded into the private working directory of the job.
+For example: run --file script.sh -- bash script.sh`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if runTemplate != "" || runBundle != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+		if runImage != "" {
+			return nil
+		}
+		return cobra.MinimumNArgs(1)(cmd, args)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resp, err := foremanClient.StartJob(cmd.Context(), &foremanpb.StartJobRequest{
-			Parent:  path.Join("users/", userName),
-			Command: args[0],
-			Args:    args[1:],
-		})
+		policy, ok := foremanpb.RestartPolicy_value["RESTART_POLICY_"+strings.ToUpper(strings.ReplaceAll(runRestart, "-", "_"))]
+		if !ok {
+			return fmt.Errorf("unknown restart policy '%s'", runRestart)
+		}
+		mounts := make([]work.Mount, 0, len(runMounts))
+		for _, s := range runMounts {
+			m, err := work.ParseMount(s)
+			if err != nil {
+				return err
+			}
+			mounts = append(mounts, m)
+		}
+
+		var config []byte
+		if runBundle != "" {
+			var err error
+			if config, err = os.ReadFile(filepath.Join(runBundle, "config.json")); err != nil {
+				return fmt.Errorf("failed to read bundle: %w", err)
+			}
+		}
+
+		parent := path.Join("users/", userName)
+		inputs, err := uploadInputs(cmd.Context(), parent, runFiles, runStdin)
+		if err != nil {
+			return err
+		}
+
+		var resp *foremanpb.JobResponse
+		if runTemplate != "" {
+			resp, err = foremanClient.StartJobFromTemplate(cmd.Context(), &foremanpb.StartJobFromTemplateRequest{
+				Parent:        parent,
+				Template:      runTemplate,
+				Parameters:    runParams,
+				Priority:      runPriority,
+				RestartPolicy: foremanpb.RestartPolicy(policy),
+				MaxAttempts:   runMaxAttempts,
+				Labels:        runLabels,
+				Annotations:   runAnnotations,
+				RequestId:     runRequestID,
+				JobId:         runJobID,
+				Inputs:        inputs,
+			})
+		} else {
+			var command string
+			if len(args) > 0 {
+				command, args = args[0], args[1:]
+			}
+			req := &foremanpb.StartJobRequest{
+				Parent:           parent,
+				Command:          command,
+				Args:             args,
+				Priority:         runPriority,
+				RestartPolicy:    foremanpb.RestartPolicy(policy),
+				MaxAttempts:      runMaxAttempts,
+				Labels:           runLabels,
+				Annotations:      runAnnotations,
+				RequestId:        runRequestID,
+				JobId:            runJobID,
+				Env:              runEnv,
+				Limits:           jobLimits(runCPU, runMemoryMiB),
+				Inputs:           inputs,
+				Artifacts:        runArtifacts,
+				Seccomp:          runSeccomp,
+				AddCapabilities:  runCapAdd,
+				DropCapabilities: runCapDrop,
+				RunAsUser:        runUser,
+				ReadOnlyPaths:    runReadOnly,
+				ReadWritePaths:   runReadWrite,
+				Mounts:           codec.MountsToPb(mounts),
+				Overlays:         runOverlays,
+				Image:            runImage,
+				BundleConfig:     config,
+				Namespaces:       runNamespaces,
+				Hostname:         runHostname,
+			}
+			if runTimeout > 0 {
+				req.Timeout = durationpb.New(runTimeout)
+			}
+			resp, err = foremanClient.StartJob(cmd.Context(), req)
+		}
 		if err != nil {
 			st := status.Convert(err)
 			slog.Error("Failed to start job", slog.Any("code", st.Code()), slog.String("error", st.Message()))
@@ -39,7 +180,136 @@ For example: run -- bash -c echo hello"`,
 	},
 }
 
+// uploadInputs uploads files and stdin for a single job, returning the name of the inputs.
+// Each file is given as local[:remote], where remote defaults to the base name of local.
+// If stdin is "-", standard input of this process is uploaded.
+// An empty name is returned if there is nothing to upload.
+func uploadInputs(ctx context.Context, parent string, files []string, stdin string) (string, error) {
+	if len(files) == 0 && stdin == "" {
+		return "", nil
+	}
+
+	stream, err := foremanClient.UploadJobInputs(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	send := func(r io.Reader, req func(chunk []byte) *foremanpb.UploadJobInputsRequest) error {
+		buf := make([]byte, uploadChunkSize)
+		sent := false
+		for {
+			n, err := r.Read(buf)
+			if n > 0 || (!sent && errors.Is(err, io.EOF)) {
+				if err := stream.Send(req(append([]byte{}, buf[:n]...))); err != nil {
+					return err
+				}
+				sent = true
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, f := range files {
+		local, remote, ok := strings.Cut(f, ":")
+		if !ok {
+			remote = filepath.Base(local)
+		}
+
+		fp, err := os.Open(local)
+		if err != nil {
+			return "", fmt.Errorf("failed to open input: %w", err)
+		}
+		info, err := fp.Stat()
+		if err == nil {
+			err = send(fp, func(chunk []byte) *foremanpb.UploadJobInputsRequest {
+				return &foremanpb.UploadJobInputsRequest{Parent: parent, Path: remote, Mode: uint32(info.Mode().Perm()), Data: chunk}
+			})
+		}
+		fp.Close()
+		if err != nil {
+			return "", fmt.Errorf("failed to upload '%s': %w", local, err)
+		}
+	}
+
+	if stdin != "" {
+		r := os.Stdin
+		if stdin != "-" {
+			if r, err = os.Open(stdin); err != nil {
+				return "", fmt.Errorf("failed to open stdin: %w", err)
+			}
+			defer r.Close()
+		}
+		err := send(r, func(chunk []byte) *foremanpb.UploadJobInputsRequest {
+			return &foremanpb.UploadJobInputsRequest{Parent: parent, Stdin: chunk}
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to upload stdin: %w", err)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		st := status.Convert(err)
+		slog.Error("Failed to upload inputs", slog.Any("code", st.Code()), slog.String("error", st.Message()))
+		return "", err
+	}
+	return resp.GetName(), nil
+}
+
 func init() {
 	clientCmd.AddCommand(runCmd)
 	runCmd.Flags().SetInterspersed(true)
+	runCmd.Flags().Int32Var(&runPriority, "priority", runPriority, "scheduling priority if the server is at capacity, higher runs first")
+	runCmd.Flags().StringVar(&runRestart, "restart", runRestart, "restart the job after it exits: never, on-failure, or always")
+	runCmd.Flags().Int32Var(&runMaxAttempts, "max-attempts", runMaxAttempts, "maximum number of attempts, including the first, if the job restarts (0 is unlimited)")
+	runCmd.Flags().StringToStringVarP(&runLabels, "label", "l", runLabels, "labels to select the job by, such as team=infra (repeatable)")
+	runCmd.Flags().StringToStringVar(&runAnnotations, "annotation", runAnnotations, "arbitrary metadata, such as description=\"nightly build\" (repeatable)")
+	runCmd.Flags().StringVar(&runRequestID, "request-id", runRequestID, "UUID to make retries of this request start at most one job")
+	runCmd.Flags().StringVar(&runJobID, "job-id", runJobID, "ID for the job instead of a generated UUID, such as nightly-build")
+	runCmd.Flags().StringToStringVar(&runEnv, "env", runEnv, "additional environment variables, such as LEVEL=debug (repeatable)")
+	runCmd.Flags().Int64Var(&runCPU, "cpu", runCPU, "CPU limit in millicores, where 1000 is one CPU (0 is the server default)")
+	runCmd.Flags().Int64Var(&runMemoryMiB, "memory", runMemoryMiB, "memory limit in MiB (0 is the server default)")
+	runCmd.Flags().DurationVar(&runTimeout, "timeout", runTimeout, "maximum duration of each attempt (0 is no timeout)")
+	runCmd.Flags().StringVar(&runTemplate, "template", runTemplate, "name of a job template to run instead of a command")
+	runCmd.Flags().StringToStringVarP(&runParams, "param", "p", runParams, "template parameter values, such as target=/srv (repeatable)")
+	runCmd.Flags().StringArrayVarP(&runFiles, "file", "f", runFiles, "local file to upload into the job working directory, as local[:remote] (repeatable)")
+	runCmd.Flags().StringVar(&runStdin, "stdin", runStdin, "file to upload as standard input of the job, or - to read this process's standard input")
+	runCmd.Flags().StringVar(&runSeccomp, "seccomp", runSeccomp, "seccomp profile that filters the system calls of the job: default, strict, or a profile supplied by the server (default is default)")
+	runCmd.Flags().StringSliceVar(&runCapAdd, "cap-add", runCapAdd, "capabilities added to the default bounding set of the job, such as NET_ADMIN, or ALL (repeatable)")
+	runCmd.Flags().StringSliceVar(&runCapDrop, "cap-drop", runCapDrop, "capabilities removed from the default bounding set of the job, such as NET_RAW, or ALL (repeatable)")
+	runCmd.Flags().Uint32Var(&runUser, "user", runUser, "uid of the job in its user namespace (0 is root in the namespace)")
+	runCmd.Flags().StringArrayVar(&runReadOnly, "read-only-path", runReadOnly, "host path the job can read; if any path is set, all others are inaccessible (repeatable)")
+	runCmd.Flags().StringArrayVar(&runReadWrite, "read-write-path", runReadWrite, "host path the job can read and write; if any path is set, all others are inaccessible (repeatable)")
+	runCmd.Flags().StringArrayVar(&runMounts, "mount", runMounts, "mount in the job sandbox, such as type=bind,source=/srv/data,target=data,readonly or type=tmpfs,target=/tmp,tmpfs-size=67108864 (repeatable)")
+	runCmd.Flags().StringArrayVar(&runOverlays, "overlay", runOverlays, "host directory the job can write to without changing the host, see client diff (repeatable)")
+	runCmd.Flags().StringVar(&runImage, "image", runImage, "name or digest of an image imported on the server to run the job in, see image import")
+	runCmd.Flags().StringVar(&runBundle, "bundle", runBundle, "directory of an OCI runtime bundle whose config.json defines the job, instead of a command")
+	runCmd.Flags().StringSliceVar(&runNamespaces, "namespace", runNamespaces, "namespace of the job, replacing the server defaults: mount, pid, user, cgroup, ipc, network, time, or uts; mount, pid, and user are required (repeatable)")
+	runCmd.Flags().StringVar(&runHostname, "hostname", runHostname, "hostname of the job, which requires a uts namespace (default is sandbox)")
+	runCmd.Flags().StringArrayVar(&runArtifacts, "artifact", runArtifacts, "glob of files in the job working directory to collect when the job terminates, such as out/*.log (repeatable)")
+	runCmd.MarkFlagsMutuallyExclusive("template", "env")
+	runCmd.MarkFlagsMutuallyExclusive("template", "cpu")
+	runCmd.MarkFlagsMutuallyExclusive("template", "memory")
+	runCmd.MarkFlagsMutuallyExclusive("template", "timeout")
+	runCmd.MarkFlagsMutuallyExclusive("template", "artifact")
+	runCmd.MarkFlagsMutuallyExclusive("template", "seccomp")
+	runCmd.MarkFlagsMutuallyExclusive("template", "cap-add")
+	runCmd.MarkFlagsMutuallyExclusive("template", "cap-drop")
+	runCmd.MarkFlagsMutuallyExclusive("template", "user")
+	runCmd.MarkFlagsMutuallyExclusive("template", "read-only-path")
+	runCmd.MarkFlagsMutuallyExclusive("template", "read-write-path")
+	runCmd.MarkFlagsMutuallyExclusive("template", "mount")
+	runCmd.MarkFlagsMutuallyExclusive("template", "overlay")
+	runCmd.MarkFlagsMutuallyExclusive("template", "image")
+	runCmd.MarkFlagsMutuallyExclusive("template", "namespace")
+	runCmd.MarkFlagsMutuallyExclusive("template", "hostname")
+	runCmd.MarkFlagsMutuallyExclusive("image", "overlay")
+	for _, flag := range []string{"template", "env", "cpu", "memory", "user", "cap-add", "cap-drop", "mount", "namespace", "hostname"} {
+		runCmd.MarkFlagsMutuallyExclusive("bundle", flag)
+	}
 }
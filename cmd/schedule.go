@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"log/slog"
+	"path"
+	"strings"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	scheduleCron        = ""
+	scheduleTimeZone    = ""
+	scheduleConcurrency = "allow"
+	scheduleSuccessful  int32
+	scheduleFailed      int32
+)
+
+// scheduleCmd is a meta command to group all schedule commands together.
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Manage recurring jobs",
+}
+
+// scheduleCreateCmd creates a schedule that starts the given command.
+var scheduleCreateCmd = &cobra.Command{
+	Use:   "create --cron <expr> <command> [args...]",
+	Short: "Create a schedule that runs a command whenever the cron expression fires",
+	Long: `Create a schedule that runs a command whenever the cron expression fires.
+
+The cron expression uses the standard 5-field format or a descriptor. For example:
+  schedule create --cron "*/5 * * * *" --tz America/New_York -- bash -c "echo hello"
+  schedule create --cron @hourly --concurrency forbid -- ./backup.sh`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := foremanClient.CreateSchedule(cmd.Context(), &foremanpb.CreateScheduleRequest{
+			Parent: path.Join("users/", userName),
+			Schedule: &foremanpb.Schedule{
+				Cron:                   scheduleCron,
+				TimeZone:               scheduleTimeZone,
+				Command:                args[0],
+				Args:                   args[1:],
+				ConcurrencyPolicy:      concurrencyPolicy(),
+				SuccessfulHistoryLimit: scheduleSuccessful,
+				FailedHistoryLimit:     scheduleFailed,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		printSchedule(resp)
+		return nil
+	},
+}
+
+// scheduleGetCmd gets a schedule and the jobs it started.
+var scheduleGetCmd = &cobra.Command{
+	Use:   "get <schedule_id>",
+	Short: "Get a schedule and all retained jobs",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := foremanClient.GetSchedule(cmd.Context(), &foremanpb.GetScheduleRequest{Name: args[0]})
+		if err != nil {
+			return err
+		}
+		printSchedule(resp)
+		return nil
+	},
+}
+
+// scheduleListCmd lists all schedules of the current user.
+var scheduleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all schedules",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		resp, err := foremanClient.ListSchedules(cmd.Context(), &foremanpb.ListSchedulesRequest{
+			Parent: path.Join("users/", userName),
+		})
+		if err != nil {
+			return err
+		}
+		for _, s := range resp.GetSchedules() {
+			printSchedule(s)
+		}
+		return nil
+	},
+}
+
+// scheduleUpdateCmd updates only the given fields of a schedule.
+var scheduleUpdateCmd = &cobra.Command{
+	Use:   "update <schedule_id> [-- <command> [args...]]",
+	Short: "Update a schedule, only the given flags and command are changed",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s := &foremanpb.Schedule{
+			Name:                   args[0],
+			Cron:                   scheduleCron,
+			TimeZone:               scheduleTimeZone,
+			ConcurrencyPolicy:      concurrencyPolicy(),
+			SuccessfulHistoryLimit: scheduleSuccessful,
+			FailedHistoryLimit:     scheduleFailed,
+		}
+
+		mask := &fieldmaskpb.FieldMask{}
+		for flag, field := range map[string]string{
+			"cron":             "cron",
+			"tz":               "time_zone",
+			"concurrency":      "concurrency_policy",
+			"successful-limit": "successful_history_limit",
+			"failed-limit":     "failed_history_limit",
+		} {
+			if cmd.Flags().Changed(flag) {
+				mask.Paths = append(mask.Paths, field)
+			}
+		}
+		if len(args) > 1 {
+			s.Command = args[1]
+			s.Args = args[2:]
+			mask.Paths = append(mask.Paths, "command", "args")
+		}
+
+		resp, err := foremanClient.UpdateSchedule(cmd.Context(), &foremanpb.UpdateScheduleRequest{
+			Schedule:   s,
+			UpdateMask: mask,
+		})
+		if err != nil {
+			return err
+		}
+		printSchedule(resp)
+		return nil
+	},
+}
+
+// scheduleDeleteCmd deletes a schedule.
+var scheduleDeleteCmd = &cobra.Command{
+	Use:   "delete <schedule_id>",
+	Short: "Delete a schedule, jobs that were already started are not stopped",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, err := foremanClient.DeleteSchedule(cmd.Context(), &foremanpb.DeleteScheduleRequest{Name: args[0]})
+		return err
+	},
+}
+
+// concurrencyPolicy converts the --concurrency flag into a [foremanpb.ConcurrencyPolicy].
+// Unknown values are sent as-is, so the server rejects them.
+func concurrencyPolicy() foremanpb.ConcurrencyPolicy {
+	name := "CONCURRENCY_POLICY_" + strings.ToUpper(scheduleConcurrency)
+	if v, ok := foremanpb.ConcurrencyPolicy_value[name]; ok {
+		return foremanpb.ConcurrencyPolicy(v)
+	}
+	return -1
+}
+
+func printSchedule(s *foremanpb.Schedule) {
+	slog.Info("Schedule",
+		slog.String("name", s.GetName()),
+		slog.String("cron", s.GetCron()),
+		slog.String("tz", s.GetTimeZone()),
+		slog.String("command", s.GetCommand()),
+		slog.Any("args", s.GetArgs()),
+		slog.Any("concurrency", s.GetConcurrencyPolicy()),
+		slog.Time("next_run", s.GetNextRunTime().AsTime()),
+	)
+	for _, j := range s.GetJobs() {
+		slog.Info("Job", slog.String("name", j))
+	}
+}
+
+func init() {
+	clientCmd.AddCommand(scheduleCmd)
+	scheduleCmd.AddCommand(scheduleCreateCmd, scheduleGetCmd, scheduleListCmd, scheduleUpdateCmd, scheduleDeleteCmd)
+
+	for _, c := range []*cobra.Command{scheduleCreateCmd, scheduleUpdateCmd} {
+		c.Flags().SetInterspersed(true)
+		c.Flags().StringVar(&scheduleCron, "cron", scheduleCron, "cron expression, such as \"0 * * * *\" or @hourly")
+		c.Flags().StringVar(&scheduleTimeZone, "tz", scheduleTimeZone, "IANA time zone used to evaluate the cron expression (default UTC)")
+		c.Flags().StringVar(&scheduleConcurrency, "concurrency", scheduleConcurrency, "what to do if previous jobs are still active: allow, forbid, or replace")
+		c.Flags().Int32Var(&scheduleSuccessful, "successful-limit", scheduleSuccessful, "number of completed jobs to keep (default 3)")
+		c.Flags().Int32Var(&scheduleFailed, "failed-limit", scheduleFailed, "number of failed jobs to keep (default 1)")
+	}
+	_ = scheduleCreateCmd.MarkFlagRequired("cron")
+}
@@ -5,6 +5,8 @@ import (
 	"log/slog"
 	"net"
 	"os/signal"
+	"path/filepath"
+	"slices"
 	"syscall"
 	"time"
 
@@ -13,7 +15,12 @@ import (
 	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
 	"github.com/drrev/telehandler/internal/auth"
 	"github.com/drrev/telehandler/internal/foreman"
+	"github.com/drrev/telehandler/pkg/image"
+	"github.com/drrev/telehandler/pkg/inputs"
+	"github.com/drrev/telehandler/pkg/schedule"
+	"github.com/drrev/telehandler/pkg/template"
 	"github.com/drrev/telehandler/pkg/work"
+	"github.com/drrev/telehandler/pkg/workflow"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 )
@@ -23,6 +30,16 @@ var (
 	listenAddress  = ":6443"
 	serverCertPath = "ssl/server.pem"
 	serverKeyPath  = "ssl/server-key.pem"
+	maxJobs        = 0
+	maxMemoryMiB   = int64(0)
+	restartBackoff = work.DefaultRestartBackoff
+	templateOnly   []string
+	workRoot       = "/tmp/telehandler"
+	maxInputsMiB   = inputs.DefaultMaxBytes >> 20
+	seccompDir     = ""
+	bindSources    []string
+	namespaces     = work.DefaultNamespaces
+	droppable      []string
 )
 
 // serverCmd runs a [foremanpb.ForemanService].
@@ -30,19 +47,48 @@ var serverCmd = &cobra.Command{
 	Use:   "server",
 	Short: "Starts a gRPC server for running and managing jobs",
 	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := work.ValidateNamespaces(namespaces); err != nil {
+			return fmt.Errorf("invalid --namespaces: %w", err)
+		}
+		for _, ns := range droppable {
+			if !slices.Contains(namespaces, ns) || slices.Contains(work.RequiredNamespaces, ns) {
+				return fmt.Errorf("invalid --droppable-namespaces: %s is not an optional namespace of --namespaces", ns)
+			}
+		}
+
 		tlsConfig, err := auth.LoadServerTLS(serverCertPath, serverKeyPath, caCertPath)
 		if err != nil {
 			return fmt.Errorf("failed to load tls config: %w", err)
 		}
 
+		policy := &auth.Policy{TemplateOnly: templateOnly}
 		server := grpc.NewServer(
 			grpc.Creds(tlsConfig),
 			grpc.InTapHandle(auth.Tap),
-			grpc.UnaryInterceptor(auth.UnaryServerInterceptor),
-			grpc.StreamInterceptor(auth.ServerStreamInterceptor),
+			grpc.UnaryInterceptor(policy.UnaryServerInterceptor),
+			grpc.StreamInterceptor(policy.ServerStreamInterceptor),
 		)
 
-		foremanpb.RegisterForemanServiceServer(server, foreman.NewService(work.NewExecutor(cgroupRoot)))
+		images, err := image.NewStore(filepath.Join(workRoot, "images"))
+		if err != nil {
+			return err
+		}
+
+		exe := work.NewExecutor(work.Settings{
+			CgroupRoot: cgroupRoot,
+			WorkRoot:   filepath.Join(workRoot, "jobs"),
+			Capacity: work.Capacity{
+				Slots:  maxJobs,
+				Memory: maxMemoryMiB << 20,
+			},
+			RestartBackoff:      restartBackoff,
+			SeccompDir:          seccompDir,
+			BindSources:         bindSources,
+			Images:              images,
+			Namespaces:          namespaces,
+			DroppableNamespaces: droppable,
+		})
+		foremanpb.RegisterForemanServiceServer(server, foreman.NewService(exe, workflow.NewManager(exe), schedule.NewManager(exe), template.NewManager(), inputs.NewStore(filepath.Join(workRoot, "inputs"), maxInputsMiB<<20)))
 
 		// intercept signals for graceful shutdown
 		basectx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
@@ -86,4 +132,14 @@ func init() {
 	serverCmd.Flags().StringVarP(&listenAddress, "listen", "l", listenAddress, "ip:port to listen on for incoming connections")
 	serverCmd.PersistentFlags().StringVarP(&serverCertPath, "cert", "c", serverCertPath, "Server cert path")
 	serverCmd.PersistentFlags().StringVarP(&serverKeyPath, "key", "k", serverKeyPath, "Server key path")
+	serverCmd.Flags().IntVar(&maxJobs, "max-jobs", maxJobs, "maximum number of concurrently running jobs, additional jobs are queued (0 is unlimited)")
+	serverCmd.Flags().Int64Var(&maxMemoryMiB, "max-memory", maxMemoryMiB, "maximum MiB of memory reserved by running jobs, additional jobs are queued (0 is unlimited)")
+	serverCmd.Flags().DurationVar(&restartBackoff, "restart-backoff", restartBackoff, "delay before a job is first restarted, doubling with each attempt")
+	serverCmd.Flags().StringVar(&workRoot, "work-root", workRoot, "directory for job working directories and uploaded inputs")
+	serverCmd.Flags().Int64Var(&maxInputsMiB, "max-inputs", maxInputsMiB, "maximum MiB of inputs uploaded for a single job")
+	serverCmd.Flags().StringVar(&seccompDir, "seccomp-profiles", seccompDir, "directory of JSON seccomp profiles that jobs may select by file name, without .json")
+	serverCmd.Flags().StringArrayVar(&bindSources, "bind-source", bindSources, "host path that jobs may bind mount, including everything beneath it (repeatable)")
+	serverCmd.Flags().StringSliceVar(&namespaces, "namespaces", namespaces, "namespaces of jobs that do not list their own: mount, pid, user, cgroup, ipc, network, time, or uts; mount, pid, and user are required")
+	serverCmd.Flags().StringSliceVar(&droppable, "droppable-namespaces", droppable, "optional namespaces of --namespaces that jobs listing their own may leave out, they may not leave out any other")
+	serverCmd.Flags().StringSliceVar(&templateOnly, "template-only", templateOnly, "users that may only start jobs from templates owned by admin (repeatable)")
 }
@@ -15,25 +15,55 @@ var statusCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		status, err := foremanClient.GetJobStatus(cmd.Context(), &foremanpb.GetJobStatusRequest{Name: args[0]})
 
-		attrs := []slog.Attr{
-			slog.String("name", status.GetName()),
-			slog.Any("state", status.GetState()),
-			slog.Time("start", status.GetStartTime().AsTime()),
+		printJobStatus(status)
+		for i, a := range status.GetAttempts() {
+			attrs := []any{
+				slog.Int("attempt", i+1),
+				slog.Time("start", a.GetStartTime().AsTime()),
+				slog.Int("exit_code", int(a.GetExitCode())),
+				slog.Int64("output_start", a.GetOutputStart()),
+				slog.Int64("output_end", a.GetOutputEnd()),
+			}
+			if a.GetSetupError() != "" {
+				attrs = append(attrs, slog.String("setup_error", a.GetSetupError()))
+			}
+			if a.GetTerminationReason() != "" {
+				attrs = append(attrs, slog.String("termination_reason", a.GetTerminationReason()))
+			}
+			slog.Info("Attempt", attrs...)
 		}
-		if status.GetEndTime().IsValid() {
-			attrs = append(attrs, slog.Time("end", status.GetEndTime().AsTime()))
-			attrs = append(attrs[:2], attrs[1:]...)
-			attrs[2] = slog.Duration("duration", status.GetEndTime().AsTime().Sub(status.GetStartTime().AsTime()))
+		for k, v := range status.GetAnnotations() {
+			slog.Info("Annotation", slog.String("key", k), slog.String("value", v))
 		}
-		if status.GetState() != foremanpb.JobState_JOB_STATE_RUNNING {
-			attrs = append(attrs, slog.Int("exit_code", int(status.GetExitCode())))
-		}
-
-		slog.Info("Status", slog.Any("job", attrs))
 		return err
 	},
 }
 
+func printJobStatus(status *foremanpb.JobStatus) {
+	attrs := []slog.Attr{
+		slog.String("name", status.GetName()),
+		slog.Any("state", status.GetState()),
+		slog.Time("start", status.GetStartTime().AsTime()),
+	}
+	if status.GetEndTime().IsValid() {
+		attrs = append(attrs, slog.Time("end", status.GetEndTime().AsTime()))
+		attrs = append(attrs[:2], attrs[1:]...)
+		attrs[2] = slog.Duration("duration", status.GetEndTime().AsTime().Sub(status.GetStartTime().AsTime()))
+	}
+	switch status.GetState() {
+	case foremanpb.JobState_JOB_STATE_RUNNING, foremanpb.JobState_JOB_STATE_PAUSED, foremanpb.JobState_JOB_STATE_RESTARTING:
+	case foremanpb.JobState_JOB_STATE_QUEUED:
+		attrs = append(attrs, slog.Int("queue_position", int(status.GetQueuePosition())))
+	default:
+		attrs = append(attrs, slog.Int("exit_code", int(status.GetExitCode())))
+	}
+	if len(status.GetLabels()) > 0 {
+		attrs = append(attrs, slog.Any("labels", status.GetLabels()))
+	}
+
+	slog.Info("Status", slog.Any("job", attrs))
+}
+
 func init() {
 	clientCmd.AddCommand(statusCmd)
 }
@@ -1,21 +1,46 @@
 package cmd
 
 import (
+	"errors"
+	"log/slog"
+	"path"
+
 	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
 	"github.com/spf13/cobra"
 )
 
+var stopSelector = ""
+
 // stopCmd attempts to stop the given Job on the Telehandler server.
 var stopCmd = &cobra.Command{
-	Use:   "stop <job_id>",
-	Short: "Attempts to stop the given job",
-	Args:  cobra.ExactArgs(1),
+	Use:   "stop <job_id> | -l <selector>",
+	Short: "Attempts to stop the given job, or all jobs matching a label selector",
+	Args:  cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		_, err := foremanClient.StopJob(cmd.Context(), &foremanpb.StopJobRequest{Name: args[0]})
-		return err
+		if len(args) == 1 {
+			_, err := foremanClient.StopJob(cmd.Context(), &foremanpb.StopJobRequest{Name: args[0]})
+			return err
+		}
+
+		if !cmd.Flags().Changed("selector") {
+			return errors.New("a job_id or --selector is required")
+		}
+
+		resp, err := foremanClient.StopJobs(cmd.Context(), &foremanpb.StopJobsRequest{
+			Parent:        path.Join("users/", userName),
+			LabelSelector: stopSelector,
+		})
+		if err != nil {
+			return err
+		}
+		for _, name := range resp.GetNames() {
+			slog.Info("Stopped", slog.String("name", name))
+		}
+		return nil
 	},
 }
 
 func init() {
 	clientCmd.AddCommand(stopCmd)
+	stopCmd.Flags().StringVarP(&stopSelector, "selector", "l", stopSelector, "stop all active jobs matching a label selector, such as team=infra,!canary")
 }
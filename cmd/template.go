@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+	"time"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	templateDescription = ""
+	templateEnv         map[string]string
+	templateCPU         int64
+	templateMemoryMiB   int64
+	templateTimeout     time.Duration
+	templateParams      []string
+	templateOwner       = ""
+)
+
+// templateCmd is a meta command to group all job template commands together.
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Manage job templates",
+}
+
+// templateCreateCmd creates a job template for the given command.
+var templateCreateCmd = &cobra.Command{
+	Use:   "create [--param <spec>]... <command> [args...]",
+	Short: "Create a job template with typed parameters",
+	Long: `Create a job template with typed parameters.
+
+Args and env values may reference parameters as ${name}. Each parameter is declared with
+--param name[:type][=default], where type is string, integer, boolean, or enum:a,b,...
+Parameters without a default are required. For example:
+  template create --param target --param retries:integer=3 --param mode:enum:full,incremental=full \
+    -- bash -c 'backup "${target}" --retries=${retries} --mode=${mode}'
+
+Templates created by admin may be run by every user.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		params := make([]*foremanpb.TemplateParameter, 0, len(templateParams))
+		for _, spec := range templateParams {
+			p, err := parseTemplateParam(spec)
+			if err != nil {
+				return err
+			}
+			params = append(params, p)
+		}
+
+		tmpl := &foremanpb.JobTemplate{
+			Description: templateDescription,
+			Command:     args[0],
+			Args:        args[1:],
+			Env:         templateEnv,
+			Limits:      jobLimits(templateCPU, templateMemoryMiB),
+			Parameters:  params,
+		}
+		if templateTimeout > 0 {
+			tmpl.Timeout = durationpb.New(templateTimeout)
+		}
+
+		resp, err := foremanClient.CreateJobTemplate(cmd.Context(), &foremanpb.CreateJobTemplateRequest{
+			Parent:      path.Join("users/", userName),
+			JobTemplate: tmpl,
+		})
+		if err != nil {
+			return err
+		}
+		printJobTemplate(resp)
+		return nil
+	},
+}
+
+// templateGetCmd gets a job template.
+var templateGetCmd = &cobra.Command{
+	Use:   "get <template_id>",
+	Short: "Get a job template and its parameters",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := foremanClient.GetJobTemplate(cmd.Context(), &foremanpb.GetJobTemplateRequest{Name: args[0]})
+		if err != nil {
+			return err
+		}
+		printJobTemplate(resp)
+		return nil
+	},
+}
+
+// templateListCmd lists all job templates of a user.
+var templateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all job templates of the current user, or of --owner",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		owner := userName
+		if templateOwner != "" {
+			owner = templateOwner
+		}
+		resp, err := foremanClient.ListJobTemplates(cmd.Context(), &foremanpb.ListJobTemplatesRequest{
+			Parent: path.Join("users/", owner),
+		})
+		if err != nil {
+			return err
+		}
+		for _, t := range resp.GetJobTemplates() {
+			printJobTemplate(t)
+		}
+		return nil
+	},
+}
+
+// templateDeleteCmd deletes a job template.
+var templateDeleteCmd = &cobra.Command{
+	Use:   "delete <template_id>",
+	Short: "Delete a job template, jobs that were already started are not stopped",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, err := foremanClient.DeleteJobTemplate(cmd.Context(), &foremanpb.DeleteJobTemplateRequest{Name: args[0]})
+		return err
+	},
+}
+
+// parseTemplateParam parses a name[:type][=default] parameter spec.
+func parseTemplateParam(spec string) (*foremanpb.TemplateParameter, error) {
+	decl, def, hasDefault := strings.Cut(spec, "=")
+	name, typ, _ := strings.Cut(decl, ":")
+
+	p := &foremanpb.TemplateParameter{Name: name, Required: !hasDefault, DefaultValue: def}
+	if allowed, ok := strings.CutPrefix(typ, "enum:"); ok {
+		typ = "enum"
+		p.AllowedValues = strings.Split(allowed, ",")
+	}
+	if typ != "" {
+		v, ok := foremanpb.ParameterType_value["PARAMETER_TYPE_"+strings.ToUpper(typ)]
+		if !ok {
+			return nil, fmt.Errorf("unknown parameter type '%s' in '%s'", typ, spec)
+		}
+		p.Type = foremanpb.ParameterType(v)
+	}
+	return p, nil
+}
+
+// jobLimits converts the CPU and memory flags into a [foremanpb.JobLimits].
+func jobLimits(cpu int64, memoryMiB int64) *foremanpb.JobLimits {
+	if cpu == 0 && memoryMiB == 0 {
+		return nil
+	}
+	return &foremanpb.JobLimits{CpuMillis: cpu, MemoryBytes: memoryMiB << 20}
+}
+
+func printJobTemplate(t *foremanpb.JobTemplate) {
+	slog.Info("Template",
+		slog.String("name", t.GetName()),
+		slog.String("description", t.GetDescription()),
+		slog.String("command", t.GetCommand()),
+		slog.Any("args", t.GetArgs()),
+		slog.Any("env", t.GetEnv()),
+		slog.Duration("timeout", t.GetTimeout().AsDuration()),
+	)
+	for _, p := range t.GetParameters() {
+		slog.Info("Parameter",
+			slog.String("name", p.GetName()),
+			slog.Any("type", p.GetType()),
+			slog.Bool("required", p.GetRequired()),
+			slog.String("default", p.GetDefaultValue()),
+			slog.Any("allowed", p.GetAllowedValues()),
+			slog.String("description", p.GetDescription()),
+		)
+	}
+}
+
+func init() {
+	clientCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateCreateCmd, templateGetCmd, templateListCmd, templateDeleteCmd)
+
+	templateCreateCmd.Flags().SetInterspersed(true)
+	templateCreateCmd.Flags().StringVar(&templateDescription, "description", templateDescription, "describes the template to its users")
+	templateCreateCmd.Flags().StringToStringVar(&templateEnv, "env", templateEnv, "environment variables, values may reference parameters (repeatable)")
+	templateCreateCmd.Flags().Int64Var(&templateCPU, "cpu", templateCPU, "CPU limit in millicores, where 1000 is one CPU (0 is the server default)")
+	templateCreateCmd.Flags().Int64Var(&templateMemoryMiB, "memory", templateMemoryMiB, "memory limit in MiB (0 is the server default)")
+	templateCreateCmd.Flags().DurationVar(&templateTimeout, "timeout", templateTimeout, "maximum duration of each job attempt (0 is no timeout)")
+	templateCreateCmd.Flags().StringArrayVar(&templateParams, "param", templateParams, "parameter declared as name[:type][=default] (repeatable)")
+
+	templateListCmd.Flags().StringVar(&templateOwner, "owner", templateOwner, "user that owns the templates, such as admin")
+}
@@ -3,29 +3,73 @@ package cmd
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"log"
 	"os"
+	"path"
+	"strings"
+	"time"
 
 	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
 	"github.com/spf13/cobra"
 )
 
+var (
+	watchSelector   = ""
+	watchTimestamps = false
+)
+
 // watchCmd streams output from the given Job.
 // Multiple streams can be active for a Job at any given time.
 var watchCmd = &cobra.Command{
-	Use:   "watch [job_id]",
-	Short: "Watch the output of a job",
+	Use:   "watch [job_id] | -l <selector>",
+	Short: "Watch the output of a job, or the status of all jobs matching a label selector",
 	Long: `Watch the output of a job starting at the beginning of execution through process termination.
 	
 Jobs do not need to be running to watch output.
-If the job is not running, all historical output from process start to finish is retrieved.`,
-	Args: cobra.ExactArgs(1),
+If the job is not running, all historical output from process start to finish is retrieved.
+
+With --timestamps, each line is prefixed with the time it was written and its stream.
+
+With --selector, the status of every matching job is printed each time it changes instead,
+until interrupted.`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return watchJobOutput(cmd.Context(), args[0])
+		if len(args) == 1 {
+			if watchTimestamps {
+				return watchJobLines(cmd.Context(), args[0])
+			}
+			return watchJobOutput(cmd.Context(), args[0])
+		}
+		if !cmd.Flags().Changed("selector") {
+			return errors.New("a job_id or --selector is required")
+		}
+		return watchJobs(cmd.Context(), watchSelector)
 	},
 }
 
+func watchJobs(ctx context.Context, selector string) error {
+	s, err := foremanClient.WatchJobs(ctx, &foremanpb.WatchJobsRequest{
+		Parent:        path.Join("users/", userName),
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		status, err := s.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		printJobStatus(status)
+	}
+}
+
 func watchJobOutput(ctx context.Context, name string) error {
 	s, err := foremanClient.WatchJobOutput(ctx, &foremanpb.WatchJobOutputRequest{Name: name})
 	if err != nil {
@@ -47,6 +91,43 @@ func watchJobOutput(ctx context.Context, name string) error {
 	}
 }
 
+// watchJobLines prints the output of a job one line at a time,
+// prefixed with the time each line was written and its stream.
+func watchJobLines(ctx context.Context, name string) error {
+	s, err := foremanClient.WatchJobOutput(ctx, &foremanpb.WatchJobOutputRequest{Name: name, Lines: true})
+	if err != nil {
+		return err
+	}
+
+	// partial lines are continued without another prefix
+	continued := make(map[foremanpb.OutputStream]bool)
+	var last *foremanpb.JobOutput
+	for {
+		out, err := s.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if last.GetPartial() && last.GetStream() != out.GetStream() {
+			// another stream interrupted a partial line
+			fmt.Println()
+			continued[last.GetStream()] = false
+		}
+		if !continued[out.GetStream()] {
+			stream := strings.ToLower(strings.TrimPrefix(out.GetStream().String(), "OUTPUT_STREAM_"))
+			fmt.Printf("%s %-6s ", out.GetTime().AsTime().Local().Format(time.RFC3339Nano), stream)
+		}
+		os.Stdout.Write(out.GetData())
+		continued[out.GetStream()] = out.GetPartial()
+		last = out
+	}
+}
+
 func init() {
 	clientCmd.AddCommand(watchCmd)
+	watchCmd.Flags().BoolVarP(&watchTimestamps, "timestamps", "t", watchTimestamps, "prefix each line of output with the time it was written and its stream")
+	watchCmd.Flags().StringVarP(&watchSelector, "selector", "l", watchSelector, "watch the status of all jobs matching a label selector, such as team=infra,!canary")
 }
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	workflowFile  = ""
+	workflowWatch = false
+)
+
+// workflowCmd is a meta command to group all workflow commands together.
+var workflowCmd = &cobra.Command{
+	Use:   "workflow",
+	Short: "Manage workflows of dependent jobs",
+}
+
+// workflowCreateCmd creates a workflow from a JSON definition.
+var workflowCreateCmd = &cobra.Command{
+	Use:   "create -f <workflow.json>",
+	Short: "Create a workflow from a JSON definition",
+	Long: `Create a workflow from a JSON definition.
+
+The definition uses the JSON mapping of the Workflow message. For example:
+
+{
+  "steps": [
+    {"id": "build", "command": "make"},
+    {"id": "test", "command": "make", "args": ["test"], "dependsOn": [{"step": "build"}]},
+    {"id": "cleanup", "command": "make", "args": ["clean"], "dependsOn": [{"step": "test", "condition": "CONDITION_ALWAYS"}]}
+  ]
+}`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		raw, err := os.ReadFile(workflowFile)
+		if err != nil {
+			return fmt.Errorf("failed to read workflow: %w", err)
+		}
+
+		var w foremanpb.Workflow
+		if err := protojson.Unmarshal(raw, &w); err != nil {
+			return fmt.Errorf("failed to parse workflow: %w", err)
+		}
+
+		resp, err := foremanClient.CreateWorkflow(cmd.Context(), &foremanpb.CreateWorkflowRequest{
+			Parent:   path.Join("users/", userName),
+			Workflow: &w,
+		})
+		if err != nil {
+			return err
+		}
+
+		printWorkflow(resp)
+		if workflowWatch {
+			return watchWorkflow(cmd, resp.GetName())
+		}
+		return nil
+	},
+}
+
+// workflowStatusCmd gets the current state of a workflow.
+var workflowStatusCmd = &cobra.Command{
+	Use:   "status <workflow_id>",
+	Short: "Get the current state of a workflow and all steps",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := foremanClient.GetWorkflow(cmd.Context(), &foremanpb.GetWorkflowRequest{Name: args[0]})
+		if err != nil {
+			return err
+		}
+		printWorkflow(resp)
+		return nil
+	},
+}
+
+// workflowWatchCmd prints the workflow every time it changes until it terminates.
+var workflowWatchCmd = &cobra.Command{
+	Use:   "watch <workflow_id>",
+	Short: "Watch a workflow until all steps terminate",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return watchWorkflow(cmd, args[0])
+	},
+}
+
+// workflowStopCmd stops all steps of a workflow.
+var workflowStopCmd = &cobra.Command{
+	Use:   "stop <workflow_id>",
+	Short: "Stop all running steps and skip all pending steps of a workflow",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, err := foremanClient.StopWorkflow(cmd.Context(), &foremanpb.StopWorkflowRequest{Name: args[0]})
+		return err
+	},
+}
+
+func watchWorkflow(cmd *cobra.Command, name string) error {
+	s, err := foremanClient.WatchWorkflow(cmd.Context(), &foremanpb.WatchWorkflowRequest{Name: name})
+	if err != nil {
+		return err
+	}
+
+	for {
+		w, err := s.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		printWorkflow(w)
+	}
+}
+
+func printWorkflow(w *foremanpb.Workflow) {
+	slog.Info("Workflow", slog.String("name", w.GetName()), slog.Any("state", w.GetState()))
+	for _, s := range w.GetSteps() {
+		slog.Info("Step", slog.String("id", s.GetId()), slog.Any("state", s.GetState()), slog.String("job", s.GetJob()))
+	}
+}
+
+func init() {
+	clientCmd.AddCommand(workflowCmd)
+	workflowCmd.AddCommand(workflowCreateCmd, workflowStatusCmd, workflowWatchCmd, workflowStopCmd)
+
+	workflowCreateCmd.Flags().StringVarP(&workflowFile, "file", "f", workflowFile, "path to a JSON workflow definition")
+	workflowCreateCmd.Flags().BoolVarP(&workflowWatch, "watch", "w", workflowWatch, "watch the workflow after it is created")
+	_ = workflowCreateCmd.MarkFlagRequired("file")
+}
@@ -25,10 +25,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ForemanService_StartJob_FullMethodName       = "/drrev.telehandler.foreman.v1alpha1.ForemanService/StartJob"
-	ForemanService_StopJob_FullMethodName        = "/drrev.telehandler.foreman.v1alpha1.ForemanService/StopJob"
-	ForemanService_GetJobStatus_FullMethodName   = "/drrev.telehandler.foreman.v1alpha1.ForemanService/GetJobStatus"
-	ForemanService_WatchJobOutput_FullMethodName = "/drrev.telehandler.foreman.v1alpha1.ForemanService/WatchJobOutput"
+	ForemanService_StartJob_FullMethodName             = "/drrev.telehandler.foreman.v1alpha1.ForemanService/StartJob"
+	ForemanService_UploadJobInputs_FullMethodName      = "/drrev.telehandler.foreman.v1alpha1.ForemanService/UploadJobInputs"
+	ForemanService_StopJob_FullMethodName              = "/drrev.telehandler.foreman.v1alpha1.ForemanService/StopJob"
+	ForemanService_PauseJob_FullMethodName             = "/drrev.telehandler.foreman.v1alpha1.ForemanService/PauseJob"
+	ForemanService_ResumeJob_FullMethodName            = "/drrev.telehandler.foreman.v1alpha1.ForemanService/ResumeJob"
+	ForemanService_GetJobStatus_FullMethodName         = "/drrev.telehandler.foreman.v1alpha1.ForemanService/GetJobStatus"
+	ForemanService_WatchJobOutput_FullMethodName       = "/drrev.telehandler.foreman.v1alpha1.ForemanService/WatchJobOutput"
+	ForemanService_DownloadArtifacts_FullMethodName    = "/drrev.telehandler.foreman.v1alpha1.ForemanService/DownloadArtifacts"
+	ForemanService_GetJobFilesystemDiff_FullMethodName = "/drrev.telehandler.foreman.v1alpha1.ForemanService/GetJobFilesystemDiff"
+	ForemanService_SearchJobOutput_FullMethodName      = "/drrev.telehandler.foreman.v1alpha1.ForemanService/SearchJobOutput"
+	ForemanService_ExecInJob_FullMethodName            = "/drrev.telehandler.foreman.v1alpha1.ForemanService/ExecInJob"
+	ForemanService_ListJobProcesses_FullMethodName     = "/drrev.telehandler.foreman.v1alpha1.ForemanService/ListJobProcesses"
+	ForemanService_ListJobs_FullMethodName             = "/drrev.telehandler.foreman.v1alpha1.ForemanService/ListJobs"
+	ForemanService_StopJobs_FullMethodName             = "/drrev.telehandler.foreman.v1alpha1.ForemanService/StopJobs"
+	ForemanService_WatchJobs_FullMethodName            = "/drrev.telehandler.foreman.v1alpha1.ForemanService/WatchJobs"
+	ForemanService_CreateWorkflow_FullMethodName       = "/drrev.telehandler.foreman.v1alpha1.ForemanService/CreateWorkflow"
+	ForemanService_GetWorkflow_FullMethodName          = "/drrev.telehandler.foreman.v1alpha1.ForemanService/GetWorkflow"
+	ForemanService_StopWorkflow_FullMethodName         = "/drrev.telehandler.foreman.v1alpha1.ForemanService/StopWorkflow"
+	ForemanService_WatchWorkflow_FullMethodName        = "/drrev.telehandler.foreman.v1alpha1.ForemanService/WatchWorkflow"
+	ForemanService_CreateSchedule_FullMethodName       = "/drrev.telehandler.foreman.v1alpha1.ForemanService/CreateSchedule"
+	ForemanService_GetSchedule_FullMethodName          = "/drrev.telehandler.foreman.v1alpha1.ForemanService/GetSchedule"
+	ForemanService_ListSchedules_FullMethodName        = "/drrev.telehandler.foreman.v1alpha1.ForemanService/ListSchedules"
+	ForemanService_UpdateSchedule_FullMethodName       = "/drrev.telehandler.foreman.v1alpha1.ForemanService/UpdateSchedule"
+	ForemanService_DeleteSchedule_FullMethodName       = "/drrev.telehandler.foreman.v1alpha1.ForemanService/DeleteSchedule"
+	ForemanService_CreateJobTemplate_FullMethodName    = "/drrev.telehandler.foreman.v1alpha1.ForemanService/CreateJobTemplate"
+	ForemanService_GetJobTemplate_FullMethodName       = "/drrev.telehandler.foreman.v1alpha1.ForemanService/GetJobTemplate"
+	ForemanService_ListJobTemplates_FullMethodName     = "/drrev.telehandler.foreman.v1alpha1.ForemanService/ListJobTemplates"
+	ForemanService_DeleteJobTemplate_FullMethodName    = "/drrev.telehandler.foreman.v1alpha1.ForemanService/DeleteJobTemplate"
+	ForemanService_StartJobFromTemplate_FullMethodName = "/drrev.telehandler.foreman.v1alpha1.ForemanService/StartJobFromTemplate"
 )
 
 // ForemanServiceClient is the client API for ForemanService service.
@@ -40,18 +65,154 @@ const (
 type ForemanServiceClient interface {
 	// Starts a job under the given parent resource.
 	//
+	// If the host is at capacity, the job is returned in JOB_STATE_QUEUED and started
+	// once capacity frees up. Queued jobs are ordered by priority, then per-user fairness.
+	//
 	// If the operation failed, the following well-defined gRPC status codes are returned:
 	//   - PERMISSION_DENIED: The requesting user does not have permission to start a new job.
-	//   - FAILED_PRECONDITION: Execution of the command was attempted, but the command failed to start.
+	//   - FAILED_PRECONDITION: Execution of the command was attempted, but the sandbox could not be set up
+	//     or the command failed to start. The message includes the reason. The job is not restarted.
+	//   - RESOURCE_EXHAUSTED: The job requires more resources than the host can ever provide.
+	//   - INVALID_ARGUMENT: A field is malformed, or request_id was reused for a different request.
+	//   - ALREADY_EXISTS: A job with the given job_id already exists.
 	StartJob(ctx context.Context, in *StartJobRequest, opts ...grpc.CallOption) (*JobResponse, error)
-	// Stops a job.
+	// Uploads files and standard input for a job that is not started yet.
+	// Files are written to the private working directory of the job once it is started
+	// with StartJobRequest.inputs. Unclaimed inputs are removed after one hour.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to upload inputs.
+	//   - INVALID_ARGUMENT: A file path is not relative, escapes the working directory, or was sent twice.
+	//   - RESOURCE_EXHAUSTED: The inputs exceed the size limit of the server.
+	UploadJobInputs(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadJobInputsRequest, JobInputs], error)
+	// Stops a job. Stopping a queued job removes it from the queue, and stopping
+	// a restarting job prevents any further attempts.
 	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pauses a running job by freezing all of its processes with the cgroup freezer,
+	// including commands run with ExecInJob. Returns once every process is frozen.
+	// Time spent paused does not count toward the job timeout. Stopping a paused
+	// job thaws it first, so it can handle the interrupt.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job is not running.
+	//   - DEADLINE_EXCEEDED: The processes were not frozen in time; the job keeps running.
+	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*JobStatus, error)
+	// Resumes a paused job where it left off. Returns once every process is thawed.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job is not paused.
+	//   - DEADLINE_EXCEEDED: The processes were not thawed in time.
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*JobStatus, error)
 	// Retrieves the current status of a given Job.
 	GetJobStatus(ctx context.Context, in *GetJobStatusRequest, opts ...grpc.CallOption) (*JobStatus, error)
 	// Watches the output for a given job. This method does not support cursors or pagination.
 	// Each new request to WatchJob will return **all** events since the start of the process.
 	// At this time, only log events are supported.
 	WatchJobOutput(ctx context.Context, in *WatchJobOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobOutput], error)
+	// Downloads the artifacts collected from a terminated job as a tar archive.
+	// Artifacts are the files in the working directory of the job that matched
+	// StartJobRequest.artifacts when the job terminated.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job has not terminated yet.
+	DownloadArtifacts(ctx context.Context, in *DownloadArtifactsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArtifactsChunk], error)
+	// Lists the files changed by a terminated job in its overlays, compared to the host
+	// as it is now, or exports them as a tar archive in the OCI image layer format.
+	// Jobs without StartJobRequest.overlays have no changes.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job has not terminated yet.
+	GetJobFilesystemDiff(ctx context.Context, in *GetJobFilesystemDiffRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobFilesystemDiff], error)
+	// Searches the output of a job for lines matching a regular expression or literal string.
+	// Only output written before the search began is searched, so the stream ends even if the
+	// job is still running. Matches are sent in order as they are found; cancelling the call
+	// stops the search.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - INVALID_ARGUMENT: The pattern is malformed, or a limit is out of range.
+	SearchJobOutput(ctx context.Context, in *SearchJobOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OutputMatch], error)
+	// Runs an additional command in the sandbox of a running job, such as a shell for debugging.
+	// The command joins the PID, mount, and UTS namespaces and the cgroup of the job.
+	// The first request starts the command; later requests send standard input or resize the terminal.
+	// Output of the command is streamed back, separate from the job output, followed by its exit code.
+	// Closing the call kills the command.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job is not running.
+	//   - INVALID_ARGUMENT: The first request has no command, or a field is malformed.
+	ExecInJob(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecInJobRequest, ExecInJobResponse], error)
+	// Lists the processes in the sandbox of a running job, including commands run with ExecInJob.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job is not running.
+	ListJobProcesses(ctx context.Context, in *ListJobProcessesRequest, opts ...grpc.CallOption) (*ListJobProcessesResponse, error)
+	// Lists all jobs under the given parent resource that match a label selector.
+	// This method does not support pagination.
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// Stops all active jobs under the given parent resource that match a label selector.
+	StopJobs(ctx context.Context, in *StopJobsRequest, opts ...grpc.CallOption) (*StopJobsResponse, error)
+	// Watches all jobs under the given parent resource that match a label selector.
+	// The status of every matching job is sent immediately, then again every time
+	// a matching job is started or changes state. The stream never ends on its own.
+	WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobStatus], error)
+	// Creates a workflow under the given parent resource.
+	// Steps are started as soon as all of their dependencies have terminated.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to create a new workflow.
+	//   - INVALID_ARGUMENT: The workflow steps are malformed or contain a dependency cycle.
+	CreateWorkflow(ctx context.Context, in *CreateWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
+	// Retrieves the current state of a given workflow, including all steps.
+	GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
+	// Stops a workflow. All queued and running steps are stopped, and all pending steps are skipped.
+	StopWorkflow(ctx context.Context, in *StopWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Watches a workflow. The current workflow is sent immediately, then again every time
+	// the workflow changes until it terminates.
+	WatchWorkflow(ctx context.Context, in *WatchWorkflowRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Workflow], error)
+	// Creates a schedule under the given parent resource.
+	// A new job is started every time the cron expression fires.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to create a new schedule.
+	//   - INVALID_ARGUMENT: The cron expression, time zone, or command is malformed.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	// Retrieves a given schedule, including the jobs it has started.
+	GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	// Lists all schedules under the given parent resource. This method does not support pagination.
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// Updates a schedule. Only the fields in update_mask are changed.
+	// Jobs that were already started are not affected.
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	// Deletes a schedule. Jobs that were already started are not stopped.
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a job template under the given parent resource.
+	// Templates owned by users/admin may be run by every user.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to create a new template,
+	//     including users that may only run templates.
+	//   - INVALID_ARGUMENT: The command, parameters, or parameter references are malformed.
+	CreateJobTemplate(ctx context.Context, in *CreateJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error)
+	// Retrieves a given job template.
+	GetJobTemplate(ctx context.Context, in *GetJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error)
+	// Lists all job templates under the given parent resource. This method does not support pagination.
+	ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error)
+	// Deletes a job template. Jobs that were already started are not stopped.
+	DeleteJobTemplate(ctx context.Context, in *DeleteJobTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Starts a job under the given parent resource from a job template.
+	// The template must be owned by the parent resource or by users/admin.
+	//
+	// If the operation failed, the well-defined gRPC status codes of StartJob are returned, and:
+	//   - NOT_FOUND: The template does not exist.
+	//   - INVALID_ARGUMENT: A parameter value is missing, undeclared, or does not match its type.
+	StartJobFromTemplate(ctx context.Context, in *StartJobFromTemplateRequest, opts ...grpc.CallOption) (*JobResponse, error)
 }
 
 type foremanServiceClient struct {
@@ -72,6 +233,19 @@ func (c *foremanServiceClient) StartJob(ctx context.Context, in *StartJobRequest
 	return out, nil
 }
 
+func (c *foremanServiceClient) UploadJobInputs(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadJobInputsRequest, JobInputs], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[0], ForemanService_UploadJobInputs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadJobInputsRequest, JobInputs]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_UploadJobInputsClient = grpc.ClientStreamingClient[UploadJobInputsRequest, JobInputs]
+
 func (c *foremanServiceClient) StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -82,6 +256,26 @@ func (c *foremanServiceClient) StopJob(ctx context.Context, in *StopJobRequest,
 	return out, nil
 }
 
+func (c *foremanServiceClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, ForemanService_PauseJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foremanServiceClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, ForemanService_ResumeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *foremanServiceClient) GetJobStatus(ctx context.Context, in *GetJobStatusRequest, opts ...grpc.CallOption) (*JobStatus, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(JobStatus)
@@ -94,7 +288,7 @@ func (c *foremanServiceClient) GetJobStatus(ctx context.Context, in *GetJobStatu
 
 func (c *foremanServiceClient) WatchJobOutput(ctx context.Context, in *WatchJobOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobOutput], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[0], ForemanService_WatchJobOutput_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[1], ForemanService_WatchJobOutput_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -111,139 +305,1011 @@ func (c *foremanServiceClient) WatchJobOutput(ctx context.Context, in *WatchJobO
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ForemanService_WatchJobOutputClient = grpc.ServerStreamingClient[JobOutput]
 
-// ForemanServiceServer is the server API for ForemanService service.
-// All implementations should embed UnimplementedForemanServiceServer
-// for forward compatibility.
-//
-// The Foreman service manages all jobs within the system.
-// This service is used to start, stop, query status, and get the output of a job.
-type ForemanServiceServer interface {
-	// Starts a job under the given parent resource.
-	//
-	// If the operation failed, the following well-defined gRPC status codes are returned:
-	//   - PERMISSION_DENIED: The requesting user does not have permission to start a new job.
-	//   - FAILED_PRECONDITION: Execution of the command was attempted, but the command failed to start.
-	StartJob(context.Context, *StartJobRequest) (*JobResponse, error)
-	// Stops a job.
-	StopJob(context.Context, *StopJobRequest) (*emptypb.Empty, error)
-	// Retrieves the current status of a given Job.
-	GetJobStatus(context.Context, *GetJobStatusRequest) (*JobStatus, error)
-	// Watches the output for a given job. This method does not support cursors or pagination.
-	// Each new request to WatchJob will return **all** events since the start of the process.
-	// At this time, only log events are supported.
-	WatchJobOutput(*WatchJobOutputRequest, grpc.ServerStreamingServer[JobOutput]) error
+func (c *foremanServiceClient) DownloadArtifacts(ctx context.Context, in *DownloadArtifactsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArtifactsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[2], ForemanService_DownloadArtifacts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadArtifactsRequest, ArtifactsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// UnimplementedForemanServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedForemanServiceServer struct{}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_DownloadArtifactsClient = grpc.ServerStreamingClient[ArtifactsChunk]
 
-func (UnimplementedForemanServiceServer) StartJob(context.Context, *StartJobRequest) (*JobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartJob not implemented")
-}
-func (UnimplementedForemanServiceServer) StopJob(context.Context, *StopJobRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopJob not implemented")
+func (c *foremanServiceClient) GetJobFilesystemDiff(ctx context.Context, in *GetJobFilesystemDiffRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobFilesystemDiff], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[3], ForemanService_GetJobFilesystemDiff_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetJobFilesystemDiffRequest, JobFilesystemDiff]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedForemanServiceServer) GetJobStatus(context.Context, *GetJobStatusRequest) (*JobStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_GetJobFilesystemDiffClient = grpc.ServerStreamingClient[JobFilesystemDiff]
+
+func (c *foremanServiceClient) SearchJobOutput(ctx context.Context, in *SearchJobOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OutputMatch], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[4], ForemanService_SearchJobOutput_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchJobOutputRequest, OutputMatch]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedForemanServiceServer) WatchJobOutput(*WatchJobOutputRequest, grpc.ServerStreamingServer[JobOutput]) error {
-	return status.Errorf(codes.Unimplemented, "method WatchJobOutput not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_SearchJobOutputClient = grpc.ServerStreamingClient[OutputMatch]
+
+func (c *foremanServiceClient) ExecInJob(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecInJobRequest, ExecInJobResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[5], ForemanService_ExecInJob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecInJobRequest, ExecInJobResponse]{ClientStream: stream}
+	return x, nil
 }
-func (UnimplementedForemanServiceServer) testEmbeddedByValue() {}
 
-// UnsafeForemanServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ForemanServiceServer will
-// result in compilation errors.
-type UnsafeForemanServiceServer interface {
-	mustEmbedUnimplementedForemanServiceServer()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_ExecInJobClient = grpc.BidiStreamingClient[ExecInJobRequest, ExecInJobResponse]
+
+func (c *foremanServiceClient) ListJobProcesses(ctx context.Context, in *ListJobProcessesRequest, opts ...grpc.CallOption) (*ListJobProcessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobProcessesResponse)
+	err := c.cc.Invoke(ctx, ForemanService_ListJobProcesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterForemanServiceServer(s grpc.ServiceRegistrar, srv ForemanServiceServer) {
-	// If the following call pancis, it indicates UnimplementedForemanServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *foremanServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, ForemanService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ForemanService_ServiceDesc, srv)
+	return out, nil
 }
 
-func _ForemanService_StartJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartJobRequest)
-	if err := dec(in); err != nil {
+func (c *foremanServiceClient) StopJobs(ctx context.Context, in *StopJobsRequest, opts ...grpc.CallOption) (*StopJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopJobsResponse)
+	err := c.cc.Invoke(ctx, ForemanService_StopJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ForemanServiceServer).StartJob(ctx, in)
+	return out, nil
+}
+
+func (c *foremanServiceClient) WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[6], ForemanService_WatchJobs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForemanService_StartJob_FullMethodName,
+	x := &grpc.GenericClientStream[WatchJobsRequest, JobStatus]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForemanServiceServer).StartJob(ctx, req.(*StartJobRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _ForemanService_StopJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopJobRequest)
-	if err := dec(in); err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_WatchJobsClient = grpc.ServerStreamingClient[JobStatus]
+
+func (c *foremanServiceClient) CreateWorkflow(ctx context.Context, in *CreateWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Workflow)
+	err := c.cc.Invoke(ctx, ForemanService_CreateWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ForemanServiceServer).StopJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForemanService_StopJob_FullMethodName,
+	return out, nil
+}
+
+func (c *foremanServiceClient) GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Workflow)
+	err := c.cc.Invoke(ctx, ForemanService_GetWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForemanServiceServer).StopJob(ctx, req.(*StopJobRequest))
+	return out, nil
+}
+
+func (c *foremanServiceClient) StopWorkflow(ctx context.Context, in *StopWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ForemanService_StopWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ForemanService_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJobStatusRequest)
-	if err := dec(in); err != nil {
+func (c *foremanServiceClient) WatchWorkflow(ctx context.Context, in *WatchWorkflowRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Workflow], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ForemanService_ServiceDesc.Streams[7], ForemanService_WatchWorkflow_FullMethodName, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ForemanServiceServer).GetJobStatus(ctx, in)
+	x := &grpc.GenericClientStream[WatchWorkflowRequest, Workflow]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForemanService_GetJobStatus_FullMethodName,
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForemanServiceServer).GetJobStatus(ctx, req.(*GetJobStatusRequest))
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_WatchWorkflowClient = grpc.ServerStreamingClient[Workflow]
+
+func (c *foremanServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ForemanService_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ForemanService_WatchJobOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchJobOutputRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *foremanServiceClient) GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ForemanService_GetSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(ForemanServiceServer).WatchJobOutput(m, &grpc.GenericServerStream[WatchJobOutputRequest, JobOutput]{ServerStream: stream})
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ForemanService_WatchJobOutputServer = grpc.ServerStreamingServer[JobOutput]
+func (c *foremanServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, ForemanService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// ForemanService_ServiceDesc is the grpc.ServiceDesc for ForemanService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ForemanService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "drrev.telehandler.foreman.v1alpha1.ForemanService",
-	HandlerType: (*ForemanServiceServer)(nil),
+func (c *foremanServiceClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ForemanService_UpdateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foremanServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ForemanService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foremanServiceClient) CreateJobTemplate(ctx context.Context, in *CreateJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobTemplate)
+	err := c.cc.Invoke(ctx, ForemanService_CreateJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foremanServiceClient) GetJobTemplate(ctx context.Context, in *GetJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobTemplate)
+	err := c.cc.Invoke(ctx, ForemanService_GetJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foremanServiceClient) ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobTemplatesResponse)
+	err := c.cc.Invoke(ctx, ForemanService_ListJobTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foremanServiceClient) DeleteJobTemplate(ctx context.Context, in *DeleteJobTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ForemanService_DeleteJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foremanServiceClient) StartJobFromTemplate(ctx context.Context, in *StartJobFromTemplateRequest, opts ...grpc.CallOption) (*JobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobResponse)
+	err := c.cc.Invoke(ctx, ForemanService_StartJobFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ForemanServiceServer is the server API for ForemanService service.
+// All implementations should embed UnimplementedForemanServiceServer
+// for forward compatibility.
+//
+// The Foreman service manages all jobs within the system.
+// This service is used to start, stop, query status, and get the output of a job.
+type ForemanServiceServer interface {
+	// Starts a job under the given parent resource.
+	//
+	// If the host is at capacity, the job is returned in JOB_STATE_QUEUED and started
+	// once capacity frees up. Queued jobs are ordered by priority, then per-user fairness.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to start a new job.
+	//   - FAILED_PRECONDITION: Execution of the command was attempted, but the sandbox could not be set up
+	//     or the command failed to start. The message includes the reason. The job is not restarted.
+	//   - RESOURCE_EXHAUSTED: The job requires more resources than the host can ever provide.
+	//   - INVALID_ARGUMENT: A field is malformed, or request_id was reused for a different request.
+	//   - ALREADY_EXISTS: A job with the given job_id already exists.
+	StartJob(context.Context, *StartJobRequest) (*JobResponse, error)
+	// Uploads files and standard input for a job that is not started yet.
+	// Files are written to the private working directory of the job once it is started
+	// with StartJobRequest.inputs. Unclaimed inputs are removed after one hour.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to upload inputs.
+	//   - INVALID_ARGUMENT: A file path is not relative, escapes the working directory, or was sent twice.
+	//   - RESOURCE_EXHAUSTED: The inputs exceed the size limit of the server.
+	UploadJobInputs(grpc.ClientStreamingServer[UploadJobInputsRequest, JobInputs]) error
+	// Stops a job. Stopping a queued job removes it from the queue, and stopping
+	// a restarting job prevents any further attempts.
+	StopJob(context.Context, *StopJobRequest) (*emptypb.Empty, error)
+	// Pauses a running job by freezing all of its processes with the cgroup freezer,
+	// including commands run with ExecInJob. Returns once every process is frozen.
+	// Time spent paused does not count toward the job timeout. Stopping a paused
+	// job thaws it first, so it can handle the interrupt.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job is not running.
+	//   - DEADLINE_EXCEEDED: The processes were not frozen in time; the job keeps running.
+	PauseJob(context.Context, *PauseJobRequest) (*JobStatus, error)
+	// Resumes a paused job where it left off. Returns once every process is thawed.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job is not paused.
+	//   - DEADLINE_EXCEEDED: The processes were not thawed in time.
+	ResumeJob(context.Context, *ResumeJobRequest) (*JobStatus, error)
+	// Retrieves the current status of a given Job.
+	GetJobStatus(context.Context, *GetJobStatusRequest) (*JobStatus, error)
+	// Watches the output for a given job. This method does not support cursors or pagination.
+	// Each new request to WatchJob will return **all** events since the start of the process.
+	// At this time, only log events are supported.
+	WatchJobOutput(*WatchJobOutputRequest, grpc.ServerStreamingServer[JobOutput]) error
+	// Downloads the artifacts collected from a terminated job as a tar archive.
+	// Artifacts are the files in the working directory of the job that matched
+	// StartJobRequest.artifacts when the job terminated.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job has not terminated yet.
+	DownloadArtifacts(*DownloadArtifactsRequest, grpc.ServerStreamingServer[ArtifactsChunk]) error
+	// Lists the files changed by a terminated job in its overlays, compared to the host
+	// as it is now, or exports them as a tar archive in the OCI image layer format.
+	// Jobs without StartJobRequest.overlays have no changes.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job has not terminated yet.
+	GetJobFilesystemDiff(*GetJobFilesystemDiffRequest, grpc.ServerStreamingServer[JobFilesystemDiff]) error
+	// Searches the output of a job for lines matching a regular expression or literal string.
+	// Only output written before the search began is searched, so the stream ends even if the
+	// job is still running. Matches are sent in order as they are found; cancelling the call
+	// stops the search.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - INVALID_ARGUMENT: The pattern is malformed, or a limit is out of range.
+	SearchJobOutput(*SearchJobOutputRequest, grpc.ServerStreamingServer[OutputMatch]) error
+	// Runs an additional command in the sandbox of a running job, such as a shell for debugging.
+	// The command joins the PID, mount, and UTS namespaces and the cgroup of the job.
+	// The first request starts the command; later requests send standard input or resize the terminal.
+	// Output of the command is streamed back, separate from the job output, followed by its exit code.
+	// Closing the call kills the command.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job is not running.
+	//   - INVALID_ARGUMENT: The first request has no command, or a field is malformed.
+	ExecInJob(grpc.BidiStreamingServer[ExecInJobRequest, ExecInJobResponse]) error
+	// Lists the processes in the sandbox of a running job, including commands run with ExecInJob.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - NOT_FOUND: No job exists with the given name.
+	//   - FAILED_PRECONDITION: The job is not running.
+	ListJobProcesses(context.Context, *ListJobProcessesRequest) (*ListJobProcessesResponse, error)
+	// Lists all jobs under the given parent resource that match a label selector.
+	// This method does not support pagination.
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// Stops all active jobs under the given parent resource that match a label selector.
+	StopJobs(context.Context, *StopJobsRequest) (*StopJobsResponse, error)
+	// Watches all jobs under the given parent resource that match a label selector.
+	// The status of every matching job is sent immediately, then again every time
+	// a matching job is started or changes state. The stream never ends on its own.
+	WatchJobs(*WatchJobsRequest, grpc.ServerStreamingServer[JobStatus]) error
+	// Creates a workflow under the given parent resource.
+	// Steps are started as soon as all of their dependencies have terminated.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to create a new workflow.
+	//   - INVALID_ARGUMENT: The workflow steps are malformed or contain a dependency cycle.
+	CreateWorkflow(context.Context, *CreateWorkflowRequest) (*Workflow, error)
+	// Retrieves the current state of a given workflow, including all steps.
+	GetWorkflow(context.Context, *GetWorkflowRequest) (*Workflow, error)
+	// Stops a workflow. All queued and running steps are stopped, and all pending steps are skipped.
+	StopWorkflow(context.Context, *StopWorkflowRequest) (*emptypb.Empty, error)
+	// Watches a workflow. The current workflow is sent immediately, then again every time
+	// the workflow changes until it terminates.
+	WatchWorkflow(*WatchWorkflowRequest, grpc.ServerStreamingServer[Workflow]) error
+	// Creates a schedule under the given parent resource.
+	// A new job is started every time the cron expression fires.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to create a new schedule.
+	//   - INVALID_ARGUMENT: The cron expression, time zone, or command is malformed.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error)
+	// Retrieves a given schedule, including the jobs it has started.
+	GetSchedule(context.Context, *GetScheduleRequest) (*Schedule, error)
+	// Lists all schedules under the given parent resource. This method does not support pagination.
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// Updates a schedule. Only the fields in update_mask are changed.
+	// Jobs that were already started are not affected.
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*Schedule, error)
+	// Deletes a schedule. Jobs that were already started are not stopped.
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*emptypb.Empty, error)
+	// Creates a job template under the given parent resource.
+	// Templates owned by users/admin may be run by every user.
+	//
+	// If the operation failed, the following well-defined gRPC status codes are returned:
+	//   - PERMISSION_DENIED: The requesting user does not have permission to create a new template,
+	//     including users that may only run templates.
+	//   - INVALID_ARGUMENT: The command, parameters, or parameter references are malformed.
+	CreateJobTemplate(context.Context, *CreateJobTemplateRequest) (*JobTemplate, error)
+	// Retrieves a given job template.
+	GetJobTemplate(context.Context, *GetJobTemplateRequest) (*JobTemplate, error)
+	// Lists all job templates under the given parent resource. This method does not support pagination.
+	ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error)
+	// Deletes a job template. Jobs that were already started are not stopped.
+	DeleteJobTemplate(context.Context, *DeleteJobTemplateRequest) (*emptypb.Empty, error)
+	// Starts a job under the given parent resource from a job template.
+	// The template must be owned by the parent resource or by users/admin.
+	//
+	// If the operation failed, the well-defined gRPC status codes of StartJob are returned, and:
+	//   - NOT_FOUND: The template does not exist.
+	//   - INVALID_ARGUMENT: A parameter value is missing, undeclared, or does not match its type.
+	StartJobFromTemplate(context.Context, *StartJobFromTemplateRequest) (*JobResponse, error)
+}
+
+// UnimplementedForemanServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedForemanServiceServer struct{}
+
+func (UnimplementedForemanServiceServer) StartJob(context.Context, *StartJobRequest) (*JobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartJob not implemented")
+}
+func (UnimplementedForemanServiceServer) UploadJobInputs(grpc.ClientStreamingServer[UploadJobInputsRequest, JobInputs]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadJobInputs not implemented")
+}
+func (UnimplementedForemanServiceServer) StopJob(context.Context, *StopJobRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopJob not implemented")
+}
+func (UnimplementedForemanServiceServer) PauseJob(context.Context, *PauseJobRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedForemanServiceServer) ResumeJob(context.Context, *ResumeJobRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedForemanServiceServer) GetJobStatus(context.Context, *GetJobStatusRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+func (UnimplementedForemanServiceServer) WatchJobOutput(*WatchJobOutputRequest, grpc.ServerStreamingServer[JobOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobOutput not implemented")
+}
+func (UnimplementedForemanServiceServer) DownloadArtifacts(*DownloadArtifactsRequest, grpc.ServerStreamingServer[ArtifactsChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadArtifacts not implemented")
+}
+func (UnimplementedForemanServiceServer) GetJobFilesystemDiff(*GetJobFilesystemDiffRequest, grpc.ServerStreamingServer[JobFilesystemDiff]) error {
+	return status.Errorf(codes.Unimplemented, "method GetJobFilesystemDiff not implemented")
+}
+func (UnimplementedForemanServiceServer) SearchJobOutput(*SearchJobOutputRequest, grpc.ServerStreamingServer[OutputMatch]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchJobOutput not implemented")
+}
+func (UnimplementedForemanServiceServer) ExecInJob(grpc.BidiStreamingServer[ExecInJobRequest, ExecInJobResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExecInJob not implemented")
+}
+func (UnimplementedForemanServiceServer) ListJobProcesses(context.Context, *ListJobProcessesRequest) (*ListJobProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobProcesses not implemented")
+}
+func (UnimplementedForemanServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedForemanServiceServer) StopJobs(context.Context, *StopJobsRequest) (*StopJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopJobs not implemented")
+}
+func (UnimplementedForemanServiceServer) WatchJobs(*WatchJobsRequest, grpc.ServerStreamingServer[JobStatus]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobs not implemented")
+}
+func (UnimplementedForemanServiceServer) CreateWorkflow(context.Context, *CreateWorkflowRequest) (*Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflow not implemented")
+}
+func (UnimplementedForemanServiceServer) GetWorkflow(context.Context, *GetWorkflowRequest) (*Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflow not implemented")
+}
+func (UnimplementedForemanServiceServer) StopWorkflow(context.Context, *StopWorkflowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopWorkflow not implemented")
+}
+func (UnimplementedForemanServiceServer) WatchWorkflow(*WatchWorkflowRequest, grpc.ServerStreamingServer[Workflow]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWorkflow not implemented")
+}
+func (UnimplementedForemanServiceServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedForemanServiceServer) GetSchedule(context.Context, *GetScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (UnimplementedForemanServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedForemanServiceServer) UpdateSchedule(context.Context, *UpdateScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedForemanServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedForemanServiceServer) CreateJobTemplate(context.Context, *CreateJobTemplateRequest) (*JobTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJobTemplate not implemented")
+}
+func (UnimplementedForemanServiceServer) GetJobTemplate(context.Context, *GetJobTemplateRequest) (*JobTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobTemplate not implemented")
+}
+func (UnimplementedForemanServiceServer) ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobTemplates not implemented")
+}
+func (UnimplementedForemanServiceServer) DeleteJobTemplate(context.Context, *DeleteJobTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobTemplate not implemented")
+}
+func (UnimplementedForemanServiceServer) StartJobFromTemplate(context.Context, *StartJobFromTemplateRequest) (*JobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartJobFromTemplate not implemented")
+}
+func (UnimplementedForemanServiceServer) testEmbeddedByValue() {}
+
+// UnsafeForemanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ForemanServiceServer will
+// result in compilation errors.
+type UnsafeForemanServiceServer interface {
+	mustEmbedUnimplementedForemanServiceServer()
+}
+
+func RegisterForemanServiceServer(s grpc.ServiceRegistrar, srv ForemanServiceServer) {
+	// If the following call pancis, it indicates UnimplementedForemanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ForemanService_ServiceDesc, srv)
+}
+
+func _ForemanService_StartJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).StartJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_StartJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).StartJob(ctx, req.(*StartJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_UploadJobInputs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ForemanServiceServer).UploadJobInputs(&grpc.GenericServerStream[UploadJobInputsRequest, JobInputs]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_UploadJobInputsServer = grpc.ClientStreamingServer[UploadJobInputsRequest, JobInputs]
+
+func _ForemanService_StopJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).StopJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_StopJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).StopJob(ctx, req.(*StopJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).PauseJob(ctx, req.(*PauseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).GetJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_GetJobStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).GetJobStatus(ctx, req.(*GetJobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_WatchJobOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobOutputRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForemanServiceServer).WatchJobOutput(m, &grpc.GenericServerStream[WatchJobOutputRequest, JobOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_WatchJobOutputServer = grpc.ServerStreamingServer[JobOutput]
+
+func _ForemanService_DownloadArtifacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadArtifactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForemanServiceServer).DownloadArtifacts(m, &grpc.GenericServerStream[DownloadArtifactsRequest, ArtifactsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_DownloadArtifactsServer = grpc.ServerStreamingServer[ArtifactsChunk]
+
+func _ForemanService_GetJobFilesystemDiff_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetJobFilesystemDiffRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForemanServiceServer).GetJobFilesystemDiff(m, &grpc.GenericServerStream[GetJobFilesystemDiffRequest, JobFilesystemDiff]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_GetJobFilesystemDiffServer = grpc.ServerStreamingServer[JobFilesystemDiff]
+
+func _ForemanService_SearchJobOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchJobOutputRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForemanServiceServer).SearchJobOutput(m, &grpc.GenericServerStream[SearchJobOutputRequest, OutputMatch]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_SearchJobOutputServer = grpc.ServerStreamingServer[OutputMatch]
+
+func _ForemanService_ExecInJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ForemanServiceServer).ExecInJob(&grpc.GenericServerStream[ExecInJobRequest, ExecInJobResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_ExecInJobServer = grpc.BidiStreamingServer[ExecInJobRequest, ExecInJobResponse]
+
+func _ForemanService_ListJobProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).ListJobProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_ListJobProcesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).ListJobProcesses(ctx, req.(*ListJobProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_StopJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).StopJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_StopJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).StopJobs(ctx, req.(*StopJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_WatchJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForemanServiceServer).WatchJobs(m, &grpc.GenericServerStream[WatchJobsRequest, JobStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_WatchJobsServer = grpc.ServerStreamingServer[JobStatus]
+
+func _ForemanService_CreateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).CreateWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_CreateWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).CreateWorkflow(ctx, req.(*CreateWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_GetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).GetWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_GetWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).GetWorkflow(ctx, req.(*GetWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_StopWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).StopWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_StopWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).StopWorkflow(ctx, req.(*StopWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_WatchWorkflow_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWorkflowRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ForemanServiceServer).WatchWorkflow(m, &grpc.GenericServerStream[WatchWorkflowRequest, Workflow]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ForemanService_WatchWorkflowServer = grpc.ServerStreamingServer[Workflow]
+
+func _ForemanService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_GetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).GetSchedule(ctx, req.(*GetScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_UpdateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_CreateJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).CreateJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_CreateJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).CreateJobTemplate(ctx, req.(*CreateJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_GetJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).GetJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_GetJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).GetJobTemplate(ctx, req.(*GetJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_ListJobTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).ListJobTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_ListJobTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).ListJobTemplates(ctx, req.(*ListJobTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_DeleteJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).DeleteJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_DeleteJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).DeleteJobTemplate(ctx, req.(*DeleteJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForemanService_StartJobFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartJobFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForemanServiceServer).StartJobFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForemanService_StartJobFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForemanServiceServer).StartJobFromTemplate(ctx, req.(*StartJobFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ForemanService_ServiceDesc is the grpc.ServiceDesc for ForemanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ForemanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drrev.telehandler.foreman.v1alpha1.ForemanService",
+	HandlerType: (*ForemanServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "StartJob",
@@ -253,17 +1319,125 @@ var ForemanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopJob",
 			Handler:    _ForemanService_StopJob_Handler,
 		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _ForemanService_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _ForemanService_ResumeJob_Handler,
+		},
 		{
 			MethodName: "GetJobStatus",
 			Handler:    _ForemanService_GetJobStatus_Handler,
 		},
+		{
+			MethodName: "ListJobProcesses",
+			Handler:    _ForemanService_ListJobProcesses_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _ForemanService_ListJobs_Handler,
+		},
+		{
+			MethodName: "StopJobs",
+			Handler:    _ForemanService_StopJobs_Handler,
+		},
+		{
+			MethodName: "CreateWorkflow",
+			Handler:    _ForemanService_CreateWorkflow_Handler,
+		},
+		{
+			MethodName: "GetWorkflow",
+			Handler:    _ForemanService_GetWorkflow_Handler,
+		},
+		{
+			MethodName: "StopWorkflow",
+			Handler:    _ForemanService_StopWorkflow_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _ForemanService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "GetSchedule",
+			Handler:    _ForemanService_GetSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _ForemanService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _ForemanService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _ForemanService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "CreateJobTemplate",
+			Handler:    _ForemanService_CreateJobTemplate_Handler,
+		},
+		{
+			MethodName: "GetJobTemplate",
+			Handler:    _ForemanService_GetJobTemplate_Handler,
+		},
+		{
+			MethodName: "ListJobTemplates",
+			Handler:    _ForemanService_ListJobTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteJobTemplate",
+			Handler:    _ForemanService_DeleteJobTemplate_Handler,
+		},
+		{
+			MethodName: "StartJobFromTemplate",
+			Handler:    _ForemanService_StartJobFromTemplate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadJobInputs",
+			Handler:       _ForemanService_UploadJobInputs_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "WatchJobOutput",
 			Handler:       _ForemanService_WatchJobOutput_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DownloadArtifacts",
+			Handler:       _ForemanService_DownloadArtifacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetJobFilesystemDiff",
+			Handler:       _ForemanService_GetJobFilesystemDiff_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchJobOutput",
+			Handler:       _ForemanService_SearchJobOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExecInJob",
+			Handler:       _ForemanService_ExecInJob_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchJobs",
+			Handler:       _ForemanService_WatchJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchWorkflow",
+			Handler:       _ForemanService_WatchWorkflow_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "drrev/telehandler/foreman/v1alpha1/telehandler.proto",
 }
@@ -2,15 +2,73 @@ package auth
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 const adminUser = "admin"
 
+// adminPrefix is the prefix of all resources owned by the admin user.
+const adminPrefix = "users/" + adminUser + "/"
+
+// commandMethods run commands chosen by the caller, rather than commands
+// from a job template.
+var commandMethods = []string{
+	foremanpb.ForemanService_StartJob_FullMethodName,
+	foremanpb.ForemanService_CreateWorkflow_FullMethodName,
+	foremanpb.ForemanService_CreateSchedule_FullMethodName,
+	foremanpb.ForemanService_UpdateSchedule_FullMethodName,
+	foremanpb.ForemanService_CreateJobTemplate_FullMethodName,
+	foremanpb.ForemanService_ExecInJob_FullMethodName,
+}
+
+// Policy configures authorization beyond resource ownership.
+// The zero value only enforces resource ownership.
+type Policy struct {
+	// TemplateOnly lists users that may not run arbitrary commands.
+	// These users may only start jobs from job templates owned by the admin user.
+	TemplateOnly []string
+}
+
+// authorize checks that cn may call method with req.
+//
+// Job templates owned by the admin user may be read and started by every user.
+func (p *Policy) authorize(cn string, method string, req any) error {
+	templateOnly := slices.Contains(p.TemplateOnly, cn)
+	if templateOnly && slices.Contains(commandMethods, method) {
+		return status.Errorf(codes.PermissionDenied, "user '%s' may only start jobs from templates", cn)
+	}
+
+	switch r := req.(type) {
+	case *foremanpb.GetJobTemplateRequest:
+		if strings.HasPrefix(r.GetName(), adminPrefix) {
+			return nil
+		}
+	case *foremanpb.ListJobTemplatesRequest:
+		if r.GetParent()+"/" == adminPrefix {
+			return nil
+		}
+	case *foremanpb.StartJobFromTemplateRequest:
+		switch {
+		case strings.HasPrefix(r.GetTemplate(), adminPrefix):
+		case templateOnly:
+			return status.Errorf(codes.PermissionDenied, "user '%s' may only start jobs from templates owned by '%s'", cn, adminUser)
+		default:
+			if err := validateName(cn, r.GetTemplate()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return validateAccess(cn, req)
+}
+
 func validateAccess(cn string, req any) error {
 	// TODO: replace with OPA or OpenFGA or similar
 	// TODO: get patterns from annotations instead of hardcoding
@@ -27,14 +85,47 @@ func validateAccess(cn string, req any) error {
 		}
 
 	case name != nil && mpr.Has(name):
-		val := mpr.Get(name).String()
-		pfx := fmt.Sprintf("users/%s/", cn)
-		if cn != adminUser && !strings.HasPrefix(val, pfx) {
-			return status.Errorf(codes.PermissionDenied, "resource '%s' is not accessible by user '%s'", val, cn)
-		}
+		return validateName(cn, mpr.Get(name).String())
+
 	default:
+		// update requests carry the resource, which holds the name (https://google.aip.dev/134)
+		if val, ok := resourceName(mpr); ok {
+			return validateName(cn, val)
+		}
 		return status.Error(codes.PermissionDenied, "message has no 'parent' or 'name'")
 	}
 
 	return nil
 }
+
+// validateName checks that the resource name is owned by cn.
+func validateName(cn string, val string) error {
+	pfx := fmt.Sprintf("users/%s/", cn)
+	if cn != adminUser && !strings.HasPrefix(val, pfx) {
+		return status.Errorf(codes.PermissionDenied, "resource '%s' is not accessible by user '%s'", val, cn)
+	}
+	return nil
+}
+
+// resourceName finds the 'name' of the single resource message set on mpr.
+func resourceName(mpr protoreflect.Message) (val string, ok bool) {
+	fields := mpr.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !mpr.Has(fd) {
+			continue
+		}
+
+		res := mpr.Get(fd).Message()
+		name := res.Descriptor().Fields().ByName("name")
+		if name == nil || !res.Has(name) {
+			continue
+		}
+		if ok {
+			// ambiguous, more than one resource
+			return "", false
+		}
+		val, ok = res.Get(name).String(), true
+	}
+	return
+}
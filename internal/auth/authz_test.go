@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"testing"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/tests/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_validateAccess(t *testing.T) {
+	tests := []struct {
+		name    string
+		cn      string
+		req     proto.Message
+		wantErr func(error) bool
+	}{
+		{
+			name:    "parent",
+			cn:      "alice",
+			req:     &foremanpb.StartJobRequest{Parent: "users/alice"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "other parent",
+			cn:      "alice",
+			req:     &foremanpb.StartJobRequest{Parent: "users/bob"},
+			wantErr: utils.ErrorTextContains(t, "PermissionDenied"),
+		},
+		{
+			name:    "name",
+			cn:      "alice",
+			req:     &foremanpb.GetJobStatusRequest{Name: "users/alice/jobs/1"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "other name",
+			cn:      "alice",
+			req:     &foremanpb.GetJobStatusRequest{Name: "users/alicia/jobs/1"},
+			wantErr: utils.ErrorTextContains(t, "PermissionDenied"),
+		},
+		{
+			name:    "admin",
+			cn:      adminUser,
+			req:     &foremanpb.GetJobStatusRequest{Name: "users/bob/jobs/1"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name: "nested resource name",
+			cn:   "alice",
+			req: &foremanpb.UpdateScheduleRequest{
+				Schedule: &foremanpb.Schedule{Name: "users/alice/schedules/1"},
+			},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name: "other nested resource name",
+			cn:   "alice",
+			req: &foremanpb.UpdateScheduleRequest{
+				Schedule: &foremanpb.Schedule{Name: "users/bob/schedules/1"},
+			},
+			wantErr: utils.ErrorTextContains(t, "PermissionDenied"),
+		},
+		{
+			name:    "no resource",
+			cn:      "alice",
+			req:     &foremanpb.UpdateScheduleRequest{Schedule: &foremanpb.Schedule{}},
+			wantErr: utils.ErrorTextContains(t, "has no 'parent' or 'name'"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAccess(tt.cn, tt.req); !tt.wantErr(err) {
+				t.Errorf("validateAccess() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestPolicy_authorize(t *testing.T) {
+	p := &Policy{TemplateOnly: []string{"carol"}}
+	tests := []struct {
+		name    string
+		cn      string
+		method  string
+		req     proto.Message
+		wantErr func(error) bool
+	}{
+		{
+			name:    "start job",
+			cn:      "alice",
+			method:  foremanpb.ForemanService_StartJob_FullMethodName,
+			req:     &foremanpb.StartJobRequest{Parent: "users/alice"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "template only start job",
+			cn:      "carol",
+			method:  foremanpb.ForemanService_StartJob_FullMethodName,
+			req:     &foremanpb.StartJobRequest{Parent: "users/carol"},
+			wantErr: utils.ErrorTextContains(t, "may only start jobs from templates"),
+		},
+		{
+			name:    "template only create template",
+			cn:      "carol",
+			method:  foremanpb.ForemanService_CreateJobTemplate_FullMethodName,
+			req:     &foremanpb.CreateJobTemplateRequest{Parent: "users/carol"},
+			wantErr: utils.ErrorTextContains(t, "may only start jobs from templates"),
+		},
+		{
+			name:    "template only exec in job",
+			cn:      "carol",
+			method:  foremanpb.ForemanService_ExecInJob_FullMethodName,
+			req:     &foremanpb.ExecInJobRequest{Name: "users/carol/jobs/1", Command: "sh"},
+			wantErr: utils.ErrorTextContains(t, "may only start jobs from templates"),
+		},
+		{
+			name:    "exec in job of another user",
+			cn:      "alice",
+			method:  foremanpb.ForemanService_ExecInJob_FullMethodName,
+			req:     &foremanpb.ExecInJobRequest{Name: "users/bob/jobs/1", Command: "sh"},
+			wantErr: utils.ErrorTextContains(t, "is not accessible"),
+		},
+		{
+			name:    "template only get job",
+			cn:      "carol",
+			method:  foremanpb.ForemanService_GetJobStatus_FullMethodName,
+			req:     &foremanpb.GetJobStatusRequest{Name: "users/carol/jobs/1"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "admin template",
+			cn:      "carol",
+			method:  foremanpb.ForemanService_StartJobFromTemplate_FullMethodName,
+			req:     &foremanpb.StartJobFromTemplateRequest{Parent: "users/carol", Template: "users/admin/jobTemplates/1"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "template only own template",
+			cn:      "carol",
+			method:  foremanpb.ForemanService_StartJobFromTemplate_FullMethodName,
+			req:     &foremanpb.StartJobFromTemplateRequest{Parent: "users/carol", Template: "users/carol/jobTemplates/1"},
+			wantErr: utils.ErrorTextContains(t, "templates owned by 'admin'"),
+		},
+		{
+			name:    "own template",
+			cn:      "alice",
+			method:  foremanpb.ForemanService_StartJobFromTemplate_FullMethodName,
+			req:     &foremanpb.StartJobFromTemplateRequest{Parent: "users/alice", Template: "users/alice/jobTemplates/1"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "other template",
+			cn:      "alice",
+			method:  foremanpb.ForemanService_StartJobFromTemplate_FullMethodName,
+			req:     &foremanpb.StartJobFromTemplateRequest{Parent: "users/alice", Template: "users/bob/jobTemplates/1"},
+			wantErr: utils.ErrorTextContains(t, "PermissionDenied"),
+		},
+		{
+			name:    "admin template for other parent",
+			cn:      "alice",
+			method:  foremanpb.ForemanService_StartJobFromTemplate_FullMethodName,
+			req:     &foremanpb.StartJobFromTemplateRequest{Parent: "users/bob", Template: "users/admin/jobTemplates/1"},
+			wantErr: utils.ErrorTextContains(t, "PermissionDenied"),
+		},
+		{
+			name:    "get admin template",
+			cn:      "carol",
+			method:  foremanpb.ForemanService_GetJobTemplate_FullMethodName,
+			req:     &foremanpb.GetJobTemplateRequest{Name: "users/admin/jobTemplates/1"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "list admin templates",
+			cn:      "carol",
+			method:  foremanpb.ForemanService_ListJobTemplates_FullMethodName,
+			req:     &foremanpb.ListJobTemplatesRequest{Parent: "users/admin"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "delete admin template",
+			cn:      "alice",
+			method:  foremanpb.ForemanService_DeleteJobTemplate_FullMethodName,
+			req:     &foremanpb.DeleteJobTemplateRequest{Name: "users/admin/jobTemplates/1"},
+			wantErr: utils.ErrorTextContains(t, "PermissionDenied"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := p.authorize(tt.cn, tt.method, tt.req); !tt.wantErr(err) {
+				t.Errorf("Policy.authorize() error = %v", err)
+			}
+		})
+	}
+}
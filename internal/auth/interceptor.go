@@ -22,29 +22,31 @@ func Tap(ctx context.Context, _ *tap.Info) (context.Context, error) {
 	return CommonNameToCtx(ctx, cns), nil
 }
 
-// UnaryServerInterceptor enforces basic resource authorization.
-func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+// UnaryServerInterceptor enforces basic resource authorization and the [Policy].
+func (p *Policy) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 	cn, err := CommonNameFromCtx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.PermissionDenied, "missing valid common name")
 	}
 
-	if err := validateAccess(cn, req); err != nil {
+	if err := p.authorize(cn, info.FullMethod, req); err != nil {
 		return nil, err
 	}
 
 	return handler(ctx, req)
 }
 
-// ServerStreamInterceptor enforces basic resource authorization.
-func ServerStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	return handler(srv, &serverStream{ServerStream: ss})
+// ServerStreamInterceptor enforces basic resource authorization and the [Policy].
+func (p *Policy) ServerStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &serverStream{ServerStream: ss, policy: p, method: info.FullMethod})
 }
 
 // serverStream wraps around the embedded grpc.ServerStream, and intercepts the RecvMsg and
 // SendMsg method call.
 type serverStream struct {
 	grpc.ServerStream
+	policy *Policy
+	method string
 }
 
 func (w *serverStream) RecvMsg(m interface{}) error {
@@ -57,7 +59,7 @@ func (w *serverStream) RecvMsg(m interface{}) error {
 		return status.Error(codes.PermissionDenied, "missing valid common name")
 	}
 
-	if err := validateAccess(cn, m); err != nil {
+	if err := w.policy.authorize(cn, w.method, m); err != nil {
 		return err
 	}
 	return nil
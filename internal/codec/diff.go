@@ -0,0 +1,28 @@
+package codec
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/work"
+)
+
+// FileChangesToPb is a convenience function for converting
+// [work.Change] into [foremanpb.FileChange].
+func FileChangesToPb(changes []work.Change) []*foremanpb.FileChange {
+	pb := make([]*foremanpb.FileChange, 0, len(changes))
+	for _, c := range changes {
+		pb = append(pb, &foremanpb.FileChange{Path: c.Path, Kind: fileChangeKindToPb(c.Kind)})
+	}
+	return pb
+}
+
+func fileChangeKindToPb(v work.ChangeKind) foremanpb.FileChangeKind {
+	switch v {
+	case work.ChangeAdded:
+		return foremanpb.FileChangeKind_FILE_CHANGE_KIND_ADDED
+	case work.ChangeModified:
+		return foremanpb.FileChangeKind_FILE_CHANGE_KIND_MODIFIED
+	case work.ChangeDeleted:
+		return foremanpb.FileChangeKind_FILE_CHANGE_KIND_DELETED
+	}
+	return foremanpb.FileChangeKind_FILE_CHANGE_KIND_UNSPECIFIED
+}
@@ -0,0 +1,19 @@
+package codec
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/inputs"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// JobInputsToPb is a convenience function for
+// converting [inputs.Staged] into a [foremanpb.JobInputs].
+func JobInputsToPb(s inputs.Staged) *foremanpb.JobInputs {
+	return &foremanpb.JobInputs{
+		Name:       s.Name,
+		Files:      s.Files,
+		SizeBytes:  s.Size,
+		Stdin:      s.Stdin,
+		ExpireTime: timestamppb.New(s.ExpireTime),
+	}
+}
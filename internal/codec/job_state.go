@@ -14,3 +14,13 @@ func JobStateToPb(v work.JobState) foremanpb.JobState {
 	}
 	return foremanpb.JobState_JOB_STATE_UNSPECIFIED
 }
+
+// RestartPolicyFromPb is a convenience function to convert from
+// [foremanpb.RestartPolicy] to [work.RestartPolicy].
+// An unspecified policy defaults to [work.RestartNever].
+func RestartPolicyFromPb(v foremanpb.RestartPolicy) work.RestartPolicy {
+	if v == foremanpb.RestartPolicy_RESTART_POLICY_UNSPECIFIED {
+		return work.RestartNever
+	}
+	return work.RestartPolicy(v.String())
+}
@@ -9,11 +9,31 @@ import (
 // JobToJobStatePb is a convenience function for
 // converting a [work.Job] into a [foremanpb.JobStatus].
 func JobToJobStatePb(job work.Job) *foremanpb.JobStatus {
+	attempts := make([]*foremanpb.JobAttempt, 0, len(job.Attempts))
+	for _, a := range job.Attempts {
+		pb := &foremanpb.JobAttempt{
+			StartTime:   timestamppb.New(a.StartTime),
+			OutputStart: a.OutputStart,
+			SetupError:  a.SetupError,
+		}
+		if !a.EndTime.IsZero() {
+			pb.EndTime = timestamppb.New(a.EndTime)
+			pb.ExitCode = int32(a.ExitCode)
+			pb.OutputEnd = a.OutputEnd
+			pb.TerminationReason = a.TerminationReason
+		}
+		attempts = append(attempts, pb)
+	}
+
 	return &foremanpb.JobStatus{
-		Name:      job.Name,
-		State:     JobStateToPb(job.State),
-		StartTime: timestamppb.New(job.StartTime),
-		EndTime:   timestamppb.New(job.EndTime),
-		ExitCode:  int32(job.ExitCode),
+		Name:          job.Name,
+		State:         JobStateToPb(job.State),
+		StartTime:     timestamppb.New(job.StartTime),
+		EndTime:       timestamppb.New(job.EndTime),
+		ExitCode:      int32(job.ExitCode),
+		QueuePosition: int32(job.QueuePosition),
+		Attempts:      attempts,
+		Labels:        job.Labels,
+		Annotations:   job.Annotations,
 	}
 }
@@ -0,0 +1,59 @@
+package codec
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/work"
+)
+
+// MountsFromPb is a convenience function for converting
+// [foremanpb.Mount] into [work.Mount]. Unspecified types are left empty,
+// so they are rejected by [work.Executor.Start].
+func MountsFromPb(pb []*foremanpb.Mount) []work.Mount {
+	mounts := make([]work.Mount, 0, len(pb))
+	for _, m := range pb {
+		mounts = append(mounts, work.Mount{
+			Type:     mountTypeFromPb(m.GetType()),
+			Source:   m.GetSource(),
+			Target:   m.GetTarget(),
+			ReadOnly: m.GetReadOnly(),
+			Size:     m.GetSizeBytes(),
+		})
+	}
+	return mounts
+}
+
+// MountsToPb is a convenience function for converting
+// [work.Mount] into [foremanpb.Mount].
+func MountsToPb(mounts []work.Mount) []*foremanpb.Mount {
+	pb := make([]*foremanpb.Mount, 0, len(mounts))
+	for _, m := range mounts {
+		pb = append(pb, &foremanpb.Mount{
+			Type:      mountTypeToPb(m.Type),
+			Source:    m.Source,
+			Target:    m.Target,
+			ReadOnly:  m.ReadOnly,
+			SizeBytes: m.Size,
+		})
+	}
+	return pb
+}
+
+func mountTypeFromPb(v foremanpb.MountType) work.MountType {
+	switch v {
+	case foremanpb.MountType_MOUNT_TYPE_BIND:
+		return work.MountBind
+	case foremanpb.MountType_MOUNT_TYPE_TMPFS:
+		return work.MountTmpfs
+	}
+	return ""
+}
+
+func mountTypeToPb(v work.MountType) foremanpb.MountType {
+	switch v {
+	case work.MountBind:
+		return foremanpb.MountType_MOUNT_TYPE_BIND
+	case work.MountTmpfs:
+		return foremanpb.MountType_MOUNT_TYPE_TMPFS
+	}
+	return foremanpb.MountType_MOUNT_TYPE_UNSPECIFIED
+}
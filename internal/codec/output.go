@@ -0,0 +1,18 @@
+package codec
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/safe"
+)
+
+// OutputStreamToPb is a convenience function to convert from
+// [safe.Stream] to [foremanpb.OutputStream].
+func OutputStreamToPb(v safe.Stream) foremanpb.OutputStream {
+	switch v {
+	case safe.Stdout:
+		return foremanpb.OutputStream_OUTPUT_STREAM_STDOUT
+	case safe.Stderr:
+		return foremanpb.OutputStream_OUTPUT_STREAM_STDERR
+	}
+	return foremanpb.OutputStream_OUTPUT_STREAM_UNSPECIFIED
+}
@@ -0,0 +1,25 @@
+package codec
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// JobProcessesToPb is a convenience function for
+// converting a slice of [work.ProcessInfo] into a [foremanpb.ListJobProcessesResponse].
+func JobProcessesToPb(procs []work.ProcessInfo) *foremanpb.ListJobProcessesResponse {
+	pb := &foremanpb.ListJobProcessesResponse{Processes: make([]*foremanpb.JobProcess, 0, len(procs))}
+	for _, p := range procs {
+		pb.Processes = append(pb.Processes, &foremanpb.JobProcess{
+			Pid:          int32(p.PID),
+			NamespacePid: int32(p.NamespacePID),
+			ParentPid:    int32(p.ParentPID),
+			Args:         p.Args,
+			State:        p.State,
+			RssBytes:     p.RSS,
+			CpuTime:      durationpb.New(p.CPUTime),
+		})
+	}
+	return pb
+}
@@ -0,0 +1,39 @@
+package codec
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/schedule"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduleToPb is a convenience function for
+// converting a [schedule.Schedule] into a [foremanpb.Schedule].
+func ScheduleToPb(s schedule.Schedule) *foremanpb.Schedule {
+	pb := &foremanpb.Schedule{
+		Name:                   s.Name,
+		Cron:                   s.Cron,
+		TimeZone:               s.TimeZone,
+		Command:                s.Cmd,
+		Args:                   s.Args,
+		ConcurrencyPolicy:      foremanpb.ConcurrencyPolicy(foremanpb.ConcurrencyPolicy_value[string(s.Concurrency)]),
+		SuccessfulHistoryLimit: int32(s.SuccessfulHistoryLimit),
+		FailedHistoryLimit:     int32(s.FailedHistoryLimit),
+		Jobs:                   s.Jobs,
+		CreateTime:             timestamppb.New(s.CreateTime),
+		NextRunTime:            timestamppb.New(s.NextRunTime),
+	}
+	if !s.LastRunTime.IsZero() {
+		pb.LastRunTime = timestamppb.New(s.LastRunTime)
+	}
+	return pb
+}
+
+// ConcurrencyPolicyFromPb is a convenience function for converting
+// a [foremanpb.ConcurrencyPolicy] into a [schedule.ConcurrencyPolicy].
+// An unspecified policy is left empty, so the default is applied.
+func ConcurrencyPolicyFromPb(p foremanpb.ConcurrencyPolicy) schedule.ConcurrencyPolicy {
+	if p == foremanpb.ConcurrencyPolicy_CONCURRENCY_POLICY_UNSPECIFIED {
+		return ""
+	}
+	return schedule.ConcurrencyPolicy(p.String())
+}
@@ -0,0 +1,41 @@
+package codec
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/work"
+)
+
+// SearchFromPb is a convenience function for
+// converting a [foremanpb.SearchJobOutputRequest] into a [work.Search].
+func SearchFromPb(pb *foremanpb.SearchJobOutputRequest) work.Search {
+	return work.Search{
+		Pattern:    pb.GetPattern(),
+		Literal:    pb.GetLiteral(),
+		IgnoreCase: pb.GetIgnoreCase(),
+		Before:     int(pb.GetBeforeContext()),
+		After:      int(pb.GetAfterContext()),
+		MaxMatches: int(pb.GetMaxMatches()),
+	}
+}
+
+// OutputMatchToPb is a convenience function for
+// converting a [work.Match] into a [foremanpb.OutputMatch].
+func OutputMatchToPb(m work.Match) *foremanpb.OutputMatch {
+	return &foremanpb.OutputMatch{
+		Line:   outputLineToPb(m.Line),
+		Before: outputLinesToPb(m.Before),
+		After:  outputLinesToPb(m.After),
+	}
+}
+
+func outputLineToPb(l work.Line) *foremanpb.OutputLine {
+	return &foremanpb.OutputLine{Offset: l.Offset, LineNumber: l.Number, Data: l.Data}
+}
+
+func outputLinesToPb(ls []work.Line) []*foremanpb.OutputLine {
+	pb := make([]*foremanpb.OutputLine, 0, len(ls))
+	for _, l := range ls {
+		pb = append(pb, outputLineToPb(l))
+	}
+	return pb
+}
@@ -0,0 +1,110 @@
+package codec
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/template"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// JobTemplateToPb is a convenience function for
+// converting a [template.Template] into a [foremanpb.JobTemplate].
+func JobTemplateToPb(t template.Template) *foremanpb.JobTemplate {
+	pb := &foremanpb.JobTemplate{
+		Name:        t.Name,
+		Description: t.Description,
+		Command:     t.Cmd,
+		Args:        t.Args,
+		Env:         EnvToPb(t.Env),
+		Limits:      LimitsToPb(t.Limits),
+		CreateTime:  timestamppb.New(t.CreateTime),
+	}
+	if t.Timeout > 0 {
+		pb.Timeout = durationpb.New(t.Timeout)
+	}
+	for _, p := range t.Params {
+		pb.Parameters = append(pb.Parameters, &foremanpb.TemplateParameter{
+			Name:          p.Name,
+			Type:          foremanpb.ParameterType(foremanpb.ParameterType_value[string(p.Type)]),
+			Description:   p.Description,
+			Required:      p.Required,
+			DefaultValue:  p.Default,
+			AllowedValues: p.Allowed,
+		})
+	}
+	return pb
+}
+
+// JobTemplateFromPb is a convenience function for converting a [foremanpb.JobTemplate]
+// into a [template.Template] owned by owner. Output only fields are ignored.
+func JobTemplateFromPb(owner string, pb *foremanpb.JobTemplate) *template.Template {
+	t := template.NewTemplate(owner, pb.GetCommand(), pb.GetArgs())
+	t.Description = pb.GetDescription()
+	t.Env = EnvFromPb(pb.GetEnv())
+	t.Limits = LimitsFromPb(pb.GetLimits())
+	t.Timeout = pb.GetTimeout().AsDuration()
+	for _, p := range pb.GetParameters() {
+		t.Params = append(t.Params, template.Param{
+			Name:        p.GetName(),
+			Type:        ParameterTypeFromPb(p.GetType()),
+			Description: p.GetDescription(),
+			Required:    p.GetRequired(),
+			Default:     p.GetDefaultValue(),
+			Allowed:     p.GetAllowedValues(),
+		})
+	}
+	return t
+}
+
+// ParameterTypeFromPb is a convenience function for converting
+// a [foremanpb.ParameterType] into a [template.ParamType].
+// An unspecified type is left empty, so the default is applied.
+func ParameterTypeFromPb(v foremanpb.ParameterType) template.ParamType {
+	if v == foremanpb.ParameterType_PARAMETER_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return template.ParamType(v.String())
+}
+
+// EnvFromPb is a convenience function for converting an env map
+// into KEY=VALUE pairs, ordered by key.
+func EnvFromPb(env map[string]string) []string {
+	var out []string
+	for _, k := range slices.Sorted(maps.Keys(env)) {
+		out = append(out, k+"="+env[k])
+	}
+	return out
+}
+
+// EnvToPb is a convenience function for converting KEY=VALUE pairs into an env map.
+func EnvToPb(env []string) map[string]string {
+	if len(env) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(env))
+	for _, kv := range env {
+		k, v, _ := strings.Cut(kv, "=")
+		out[k] = v
+	}
+	return out
+}
+
+// LimitsFromPb is a convenience function for converting
+// a [foremanpb.JobLimits] into [work.Limits].
+func LimitsFromPb(pb *foremanpb.JobLimits) work.Limits {
+	return work.Limits{CPU: pb.GetCpuMillis(), Memory: pb.GetMemoryBytes()}
+}
+
+// LimitsToPb is a convenience function for converting
+// [work.Limits] into a [foremanpb.JobLimits].
+func LimitsToPb(l work.Limits) *foremanpb.JobLimits {
+	if l == (work.Limits{}) {
+		return nil
+	}
+	return &foremanpb.JobLimits{CpuMillis: l.CPU, MemoryBytes: l.Memory}
+}
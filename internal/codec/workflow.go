@@ -0,0 +1,67 @@
+package codec
+
+import (
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WorkflowToPb is a convenience function for
+// converting a [workflow.Workflow] into a [foremanpb.Workflow].
+func WorkflowToPb(w workflow.Workflow) *foremanpb.Workflow {
+	steps := make([]*foremanpb.WorkflowStep, 0, len(w.Steps))
+	for _, s := range w.Steps {
+		deps := make([]*foremanpb.StepDependency, 0, len(s.DependsOn))
+		for _, d := range s.DependsOn {
+			deps = append(deps, &foremanpb.StepDependency{
+				Step:      d.Step,
+				Condition: foremanpb.Condition(foremanpb.Condition_value[string(d.Condition)]),
+			})
+		}
+
+		steps = append(steps, &foremanpb.WorkflowStep{
+			Id:        s.ID,
+			Command:   s.Cmd,
+			Args:      s.Args,
+			DependsOn: deps,
+			Job:       s.Job,
+			State:     foremanpb.StepState(foremanpb.StepState_value[string(s.State)]),
+		})
+	}
+
+	pb := &foremanpb.Workflow{
+		Name:       w.Name,
+		Steps:      steps,
+		State:      foremanpb.WorkflowState(foremanpb.WorkflowState_value[string(w.State)]),
+		CreateTime: timestamppb.New(w.CreateTime),
+	}
+	if !w.Running() {
+		pb.EndTime = timestamppb.New(w.EndTime)
+	}
+	return pb
+}
+
+// StepsFromPb is a convenience function for converting
+// [foremanpb.WorkflowStep] values into [workflow.Step] values.
+// Output only fields are ignored.
+func StepsFromPb(pb []*foremanpb.WorkflowStep) []workflow.Step {
+	steps := make([]workflow.Step, 0, len(pb))
+	for _, s := range pb {
+		deps := make([]workflow.Dependency, 0, len(s.GetDependsOn()))
+		for _, d := range s.GetDependsOn() {
+			cond := workflow.Condition(d.GetCondition().String())
+			if d.GetCondition() == foremanpb.Condition_CONDITION_UNSPECIFIED {
+				cond = workflow.OnSuccess
+			}
+			deps = append(deps, workflow.Dependency{Step: d.GetStep(), Condition: cond})
+		}
+
+		steps = append(steps, workflow.Step{
+			ID:        s.GetId(),
+			Cmd:       s.GetCommand(),
+			Args:      s.GetArgs(),
+			DependsOn: deps,
+		})
+	}
+	return steps
+}
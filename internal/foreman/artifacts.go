@@ -0,0 +1,57 @@
+package foreman
+
+import (
+	"bufio"
+	"errors"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// artifactsChunkSize is the size of each chunk of the artifacts archive sent to the client.
+const artifactsChunkSize = 64 << 10
+
+// DownloadArtifacts implements foremanpb.ForemanServiceServer.
+func (s *Service) DownloadArtifacts(req *foremanpb.DownloadArtifactsRequest, srv grpc.ServerStreamingServer[foremanpb.ArtifactsChunk]) error {
+	ctx := srv.Context()
+
+	if _, err := s.exe.Lookup(req.GetName()); err != nil {
+		return status.Errorf(codes.NotFound, "no job found for '%v'", req.GetName())
+	}
+
+	w := bufio.NewWriterSize(chunkWriter{srv}, artifactsChunkSize)
+	err := s.exe.WriteArtifacts(req.GetName(), w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		var state *work.ErrInvalidJobState
+		if errors.As(err, &state) {
+			return status.Errorf(codes.FailedPrecondition, "job has not terminated: %v", err)
+		}
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		slog.ErrorContext(ctx, "Failed to download artifacts", slog.String("name", req.GetName()), slog.Any("error", err))
+		return status.Error(codes.Internal, "failed to download artifacts")
+	}
+
+	return nil
+}
+
+// chunkWriter sends every write to the client as a single [foremanpb.ArtifactsChunk].
+type chunkWriter struct {
+	srv grpc.ServerStreamingServer[foremanpb.ArtifactsChunk]
+}
+
+// Write implements io.Writer.
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w.srv.Send(&foremanpb.ArtifactsChunk{Data: append([]byte{}, p...)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,68 @@
+package foreman
+
+import (
+	"bufio"
+	"errors"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// diffBatchSize is the maximum number of changes sent in each message.
+const diffBatchSize = 1000
+
+// GetJobFilesystemDiff implements foremanpb.ForemanServiceServer.
+func (s *Service) GetJobFilesystemDiff(req *foremanpb.GetJobFilesystemDiffRequest, srv grpc.ServerStreamingServer[foremanpb.JobFilesystemDiff]) error {
+	ctx := srv.Context()
+
+	if _, err := s.exe.Lookup(req.GetName()); err != nil {
+		return status.Errorf(codes.NotFound, "no job found for '%v'", req.GetName())
+	}
+
+	var err error
+	if req.GetExport() {
+		w := bufio.NewWriterSize(diffWriter{srv}, artifactsChunkSize)
+		if err = s.exe.WriteFilesystemDiff(req.GetName(), w); err == nil {
+			err = w.Flush()
+		}
+	} else {
+		var changes []work.Change
+		changes, err = s.exe.FilesystemDiff(req.GetName())
+		for len(changes) > 0 && err == nil {
+			n := min(len(changes), diffBatchSize)
+			err = srv.Send(&foremanpb.JobFilesystemDiff{Changes: codec.FileChangesToPb(changes[:n])})
+			changes = changes[n:]
+		}
+	}
+	if err != nil {
+		var state *work.ErrInvalidJobState
+		if errors.As(err, &state) {
+			return status.Errorf(codes.FailedPrecondition, "job has not terminated: %v", err)
+		}
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		slog.ErrorContext(ctx, "Failed to diff filesystem", slog.String("name", req.GetName()), slog.Any("error", err))
+		return status.Error(codes.Internal, "failed to diff filesystem")
+	}
+
+	return nil
+}
+
+// diffWriter sends every write to the client as a single [foremanpb.JobFilesystemDiff].
+type diffWriter struct {
+	srv grpc.ServerStreamingServer[foremanpb.JobFilesystemDiff]
+}
+
+// Write implements io.Writer.
+func (w diffWriter) Write(p []byte) (int, error) {
+	if err := w.srv.Send(&foremanpb.JobFilesystemDiff{Data: append([]byte{}, p...)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
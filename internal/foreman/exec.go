@@ -0,0 +1,152 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExecInJob implements foremanpb.ForemanServiceServer.
+func (s *Service) ExecInJob(srv grpc.BidiStreamingServer[foremanpb.ExecInJobRequest, foremanpb.ExecInJobResponse]) error {
+	ctx := srv.Context()
+
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	if req.GetCommand() == "" {
+		return status.Error(codes.InvalidArgument, "the first request must have a command")
+	}
+	if err := validateEnv(req.GetEnv()); err != nil {
+		return err
+	}
+
+	name := req.GetName()
+	proc, err := s.exe.Exec(name, work.ExecSpec{
+		Cmd:  req.GetCommand(),
+		Args: req.GetArgs(),
+		Env:  codec.EnvFromPb(req.GetEnv()),
+		TTY:  req.GetTty(),
+		Rows: uint16(req.GetTerminalSize().GetRows()),
+		Cols: uint16(req.GetTerminalSize().GetCols()),
+	})
+	if err != nil {
+		return execError(name, err)
+	}
+	slog.InfoContext(ctx, "Exec started", slog.String("name", name), slog.String("cmd", req.GetCommand()), slog.Any("args", req.GetArgs()))
+
+	// the command is killed if the client leaves early
+	stop := context.AfterFunc(ctx, proc.Kill)
+	defer stop()
+
+	// input is copied until the client closes its side of the stream
+	go func(req *foremanpb.ExecInJobRequest) {
+		for {
+			if err := writeExecInput(proc, req); err != nil {
+				slog.WarnContext(ctx, "Failed to write exec input", slog.String("name", name), slog.Any("error", err))
+			}
+
+			next, err := srv.Recv()
+			if errors.Is(err, io.EOF) {
+				_ = proc.CloseStdin()
+			}
+			if err != nil {
+				return
+			}
+			if next.GetName() != name {
+				proc.Kill()
+				return
+			}
+			req = next
+		}
+	}(req)
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	send := func(resp *foremanpb.ExecInJobResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return srv.Send(resp)
+	}
+	copyOutput := func(r io.ReadCloser, stream foremanpb.OutputStream) {
+		defer wg.Done()
+		defer r.Close()
+
+		buf := make([]byte, 10240)
+		for {
+			n, err := r.Read(buf)
+			if n > 0 {
+				if err := send(&foremanpb.ExecInJobResponse{Data: append([]byte{}, buf[:n]...), Stream: stream}); err != nil {
+					proc.Kill()
+					return
+				}
+			}
+			// a terminal returns EIO once the command exits
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	wg.Add(1)
+	go copyOutput(proc.Stdout, foremanpb.OutputStream_OUTPUT_STREAM_STDOUT)
+	if proc.Stderr != nil {
+		wg.Add(1)
+		go copyOutput(proc.Stderr, foremanpb.OutputStream_OUTPUT_STREAM_STDERR)
+	}
+
+	code := proc.Wait()
+	wg.Wait()
+	slog.InfoContext(ctx, "Exec exited", slog.String("name", name), slog.Int("exit_code", code))
+
+	return send(&foremanpb.ExecInJobResponse{Exited: true, ExitCode: int32(code)})
+}
+
+// writeExecInput applies the standard input and terminal size of req to proc.
+func writeExecInput(proc *work.Process, req *foremanpb.ExecInJobRequest) error {
+	if size := req.GetTerminalSize(); size != nil {
+		if err := proc.Resize(uint16(size.GetRows()), uint16(size.GetCols())); err != nil {
+			return err
+		}
+	}
+	if len(req.GetStdin()) > 0 {
+		if _, err := proc.Stdin.Write(req.GetStdin()); err != nil {
+			return err
+		}
+	}
+	if req.GetCloseStdin() {
+		return proc.CloseStdin()
+	}
+	return nil
+}
+
+// execError maps errors from [Executor.Exec] to gRPC status errors.
+func execError(name string, err error) error {
+	var (
+		notFound *work.ErrJobNotFound
+		state    *work.ErrInvalidJobState
+		invalid  *work.ErrInvalidJob
+	)
+	switch {
+	case errors.As(err, &notFound):
+		return status.Errorf(codes.NotFound, "no job found for '%v'", name)
+	case errors.As(err, &state):
+		return status.Errorf(codes.FailedPrecondition, "job is not running: %v", err)
+	case errors.As(err, &invalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	slog.Error("Failed to exec in job", slog.String("name", name), slog.Any("error", err))
+	return status.Error(codes.Internal, "failed to exec in job")
+}
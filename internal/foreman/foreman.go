@@ -1,18 +1,26 @@
 package foreman
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
 	"log/slog"
+	"path"
+	"strings"
 
 	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
 	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/labels"
+	"github.com/drrev/telehandler/pkg/landlock"
 	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/pkg/spec"
 	"github.com/drrev/telehandler/pkg/work"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -20,19 +28,34 @@ import (
 type Executor interface {
 	Start(j work.Job) (work.Job, error)
 	Lookup(name string) (work.Job, error)
+	List(owner string, sel labels.Selector) []work.Job
 	OpenReader(name string) (*safe.NotifyingBufferReader, error)
+	WriteArtifacts(name string, w io.Writer) error
+	FilesystemDiff(name string) ([]work.Change, error)
+	WriteFilesystemDiff(name string, w io.Writer) error
+	SearchOutput(ctx context.Context, name string, s work.Search, fn func(work.Match) error) error
+	Exec(name string, spec work.ExecSpec) (*work.Process, error)
+	Processes(name string) ([]work.ProcessInfo, error)
 	Stop(name string) error
+	Pause(ctx context.Context, name string) error
+	Resume(ctx context.Context, name string) error
+	Changes() <-chan struct{}
 }
 
 // Service implements [foremanpb.ForemanServiceServer].
 type Service struct {
-	exe Executor
+	exe       Executor
+	flows     Workflows
+	scheds    Schedules
+	templates Templates
+	inputs    Inputs
+	starts    requestCache
 }
 
 // NewService creates a new [Service] instance that implements [foremanpb.ForemanServiceServer] and
 // can be registered with [foremanpb.RegisterForemanServiceServer].
-func NewService(exe Executor) *Service {
-	return &Service{exe}
+func NewService(exe Executor, flows Workflows, scheds Schedules, templates Templates, inputs Inputs) *Service {
+	return &Service{exe: exe, flows: flows, scheds: scheds, templates: templates, inputs: inputs}
 }
 
 // GetJobStatus implements foremanpb.ForemanServiceServer.
@@ -46,16 +69,226 @@ func (s *Service) GetJobStatus(ctx context.Context, req *foremanpb.GetJobStatusR
 
 // StartJob implements foremanpb.ForemanServiceServer.
 func (s *Service) StartJob(ctx context.Context, req *foremanpb.StartJobRequest) (*foremanpb.JobResponse, error) {
-	job, err := s.exe.Start(*work.NewJob(req.GetParent(), req.GetCommand(), req.GetArgs()))
+	if err := validateStart(req); err != nil {
+		return nil, err
+	}
+	if err := validateEnv(req.GetEnv()); err != nil {
+		return nil, err
+	}
+
+	job, err := newJob(req)
+	if err != nil {
+		return nil, err
+	}
+	job.Cmd = req.GetCommand()
+	job.Args = req.GetArgs()
+	job.Env = codec.EnvFromPb(req.GetEnv())
+	job.Limits = codec.LimitsFromPb(req.GetLimits())
+	job.Timeout = req.GetTimeout().AsDuration()
+	job.Artifacts = req.GetArtifacts()
+	job.Seccomp = req.GetSeccomp()
+	job.Capabilities = work.Capabilities{Add: req.GetAddCapabilities(), Drop: req.GetDropCapabilities()}
+	job.RunAsUser = int(req.GetRunAsUser())
+	job.Landlock = landlock.Ruleset{ReadOnly: req.GetReadOnlyPaths(), ReadWrite: req.GetReadWritePaths()}
+	job.Mounts = codec.MountsFromPb(req.GetMounts())
+	job.Overlays = req.GetOverlays()
+	job.Image = req.GetImage()
+	if namespaces := req.GetNamespaces(); len(namespaces) > 0 {
+		job.Namespaces = namespaces
+	}
+	job.Hostname = req.GetHostname()
+	if err := applyBundleConfig(req, job); err != nil {
+		return nil, err
+	}
+
+	return s.startOnce(ctx, req, job)
+}
+
+// applyBundleConfig configures job from the bundle_config of req, if it is set.
+// Fields of req that the config replaces must not be set.
+func applyBundleConfig(req *foremanpb.StartJobRequest, job *work.Job) error {
+	if len(req.GetBundleConfig()) == 0 {
+		return nil
+	}
+	// in the order of the request, so the same field is always reported
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"command", req.GetCommand() != ""},
+		{"args", len(req.GetArgs()) > 0},
+		{"env", len(req.GetEnv()) > 0},
+		{"limits", req.GetLimits() != nil},
+		{"run_as_user", req.GetRunAsUser() != 0},
+		{"add_capabilities", len(req.GetAddCapabilities()) > 0},
+		{"drop_capabilities", len(req.GetDropCapabilities()) > 0},
+		{"mounts", len(req.GetMounts()) > 0},
+		{"namespaces", len(req.GetNamespaces()) > 0},
+		{"hostname", req.GetHostname() != ""},
+	} {
+		if f.set {
+			return status.Errorf(codes.InvalidArgument, "%s cannot be set along with bundle_config", f.name)
+		}
+	}
+
+	s, err := spec.Parse(bytes.NewReader(req.GetBundleConfig()))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := job.ApplySpec(s); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// startRequest holds the fields shared by StartJob and StartJobFromTemplate requests.
+type startRequest interface {
+	proto.Message
+	GetParent() string
+	GetPriority() int32
+	GetRestartPolicy() foremanpb.RestartPolicy
+	GetMaxAttempts() int32
+	GetLabels() map[string]string
+	GetAnnotations() map[string]string
+	GetRequestId() string
+	GetJobId() string
+	GetInputs() string
+}
+
+// validateStart checks the fields shared by all start requests.
+func validateStart(req startRequest) error {
+	if _, ok := foremanpb.RestartPolicy_name[int32(req.GetRestartPolicy())]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown restart policy %v", req.GetRestartPolicy())
+	}
+	if req.GetMaxAttempts() < 0 {
+		return status.Error(codes.InvalidArgument, "max_attempts must not be negative")
+	}
+	if id := req.GetRequestId(); id != "" {
+		if _, err := uuid.Parse(id); err != nil {
+			return status.Errorf(codes.InvalidArgument, "request_id '%s' is not a UUID", id)
+		}
+	}
+	return nil
+}
+
+// validateEnv checks that every environment variable name can be passed to a process.
+func validateEnv(env map[string]string) error {
+	for k := range env {
+		if k == "" || strings.ContainsAny(k, "=\x00") {
+			return status.Errorf(codes.InvalidArgument, "environment variable name '%s' is invalid", k)
+		}
+	}
+	return nil
+}
+
+// newJob creates a [work.Job] without a command from the fields shared by all start requests.
+func newJob(req startRequest) (*work.Job, error) {
+	job := work.NewJob(req.GetParent(), "", nil)
+	if id := req.GetJobId(); id != "" {
+		var err error
+		if job, err = work.NewJobWithID(req.GetParent(), id, "", nil); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	job.Priority = int(req.GetPriority())
+	job.Restart = codec.RestartPolicyFromPb(req.GetRestartPolicy())
+	job.MaxAttempts = int(req.GetMaxAttempts())
+	job.Labels = req.GetLabels()
+	job.Annotations = req.GetAnnotations()
+	return job, nil
+}
+
+// startOnce starts job, unless req has a request_id that was already used
+// to start a Job, or req has a job_id that is already taken.
+// Any inputs of req are claimed only if a new Job is started.
+func (s *Service) startOnce(ctx context.Context, req startRequest, job *work.Job) (*foremanpb.JobResponse, error) {
+	if req.GetRequestId() == "" && req.GetJobId() == "" {
+		if err := s.claimInputs(req, job); err != nil {
+			return nil, err
+		}
+		return s.startJob(ctx, *job)
+	}
+
+	key, digest := path.Join(req.GetParent(), req.GetRequestId()), requestDigest(req)
+	var keys []string
+	if req.GetRequestId() != "" {
+		keys = append(keys, "request_id:"+key)
+	}
+	if req.GetJobId() != "" {
+		keys = append(keys, "job:"+job.Name)
+	}
+
+	// serialize starts that may collide with each other, but not with any other start,
+	// since starting a Job waits for its sandbox to be set up
+	release, err := s.starts.acquire(ctx, keys...)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	defer release()
+
+	if req.GetRequestId() != "" {
+		s.starts.mu.Lock()
+		e, ok := s.starts.get(key)
+		s.starts.mu.Unlock()
+		if ok {
+			if e.digest != digest {
+				return nil, status.Errorf(codes.InvalidArgument, "request_id '%s' was already used for a different request", req.GetRequestId())
+			}
+			if prev, err := s.exe.Lookup(e.name); err == nil {
+				return jobResponse(prev), nil
+			}
+		}
+	}
+
+	if req.GetJobId() != "" {
+		if _, err := s.exe.Lookup(job.Name); err == nil {
+			return nil, status.Errorf(codes.AlreadyExists, "job '%s' already exists", job.Name)
+		}
+	}
+
+	if err := s.claimInputs(req, job); err != nil {
+		return nil, err
+	}
+	resp, err := s.startJob(ctx, *job)
+	if err == nil && req.GetRequestId() != "" {
+		s.starts.mu.Lock()
+		s.starts.put(key, requestEntry{name: resp.GetName(), digest: digest})
+		s.starts.mu.Unlock()
+	}
+	return resp, err
+}
+
+// startJob starts job and maps any error to a gRPC status.
+func (s *Service) startJob(ctx context.Context, job work.Job) (*foremanpb.JobResponse, error) {
+	job, err := s.exe.Start(job)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to start job", slog.String("cmd", req.GetCommand()), slog.Any("args", req.GetArgs()))
+		slog.ErrorContext(ctx, "Failed to start job", slog.String("cmd", job.Cmd), slog.Any("args", job.Args))
+		if errors.Is(err, work.ErrExceedsCapacity) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		var (
+			invalid    *labels.ErrInvalid
+			invalidJob *work.ErrInvalidJob
+		)
+		if errors.As(err, &invalid) || errors.As(err, &invalidJob) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		var setup *work.ErrSetupFailed
+		if errors.As(err, &setup) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to start job")
 	}
 
+	return jobResponse(job), nil
+}
+
+func jobResponse(job work.Job) *foremanpb.JobResponse {
 	return &foremanpb.JobResponse{
 		Name:  job.Name,
+		Uid:   job.UID,
 		State: codec.JobStateToPb(job.State),
-	}, nil
+	}
 }
 
 // StopJob implements foremanpb.ForemanServiceServer.
@@ -77,12 +310,23 @@ func (s *Service) WatchJobOutput(req *foremanpb.WatchJobOutputRequest, srv grpc.
 		return err
 	}
 
+	flush := defaultFlushInterval
+	if d := req.GetFlushInterval(); d != nil {
+		if flush = d.AsDuration(); flush <= 0 {
+			return status.Error(codes.InvalidArgument, "flush_interval must be positive")
+		}
+	}
+
 	r, err := s.exe.OpenReader(job.Name)
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to open job output: %v", err)
 	}
 	context.AfterFunc(ctx, func() { r.Close() })
 
+	if req.GetLines() {
+		return watchLines(ctx, r, flush, srv)
+	}
+
 	buf := make([]byte, 10240)
 
 	// drain buffer
@@ -105,3 +349,84 @@ func (s *Service) WatchJobOutput(req *foremanpb.WatchJobOutputRequest, srv grpc.
 
 	return nil
 }
+
+// ListJobs implements foremanpb.ForemanServiceServer.
+func (s *Service) ListJobs(ctx context.Context, req *foremanpb.ListJobsRequest) (*foremanpb.ListJobsResponse, error) {
+	sel, err := labels.Parse(req.GetLabelSelector())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	jobs := s.exe.List(req.GetParent(), sel)
+
+	resp := &foremanpb.ListJobsResponse{Jobs: make([]*foremanpb.JobStatus, 0, len(jobs))}
+	for _, job := range jobs {
+		resp.Jobs = append(resp.Jobs, codec.JobToJobStatePb(job))
+	}
+	return resp, nil
+}
+
+// StopJobs implements foremanpb.ForemanServiceServer.
+func (s *Service) StopJobs(ctx context.Context, req *foremanpb.StopJobsRequest) (*foremanpb.StopJobsResponse, error) {
+	sel, err := labels.Parse(req.GetLabelSelector())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &foremanpb.StopJobsResponse{}
+	for _, job := range s.exe.List(req.GetParent(), sel) {
+		if !job.Active() {
+			continue
+		}
+		if err := s.exe.Stop(job.Name); err != nil {
+			// the job may have exited since it was listed
+			slog.WarnContext(ctx, "Failed to stop job", slog.String("name", job.Name), slog.Any("error", err))
+			continue
+		}
+		resp.Names = append(resp.Names, job.Name)
+	}
+	return resp, nil
+}
+
+// WatchJobs implements foremanpb.ForemanServiceServer.
+func (s *Service) WatchJobs(req *foremanpb.WatchJobsRequest, srv grpc.ServerStreamingServer[foremanpb.JobStatus]) error {
+	ctx := srv.Context()
+
+	sel, err := labels.Parse(req.GetLabelSelector())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sent := make(map[string]*foremanpb.JobStatus)
+	for ctx.Err() == nil {
+		// grab the channel before listing, so no changes are missed
+		changes := s.exe.Changes()
+
+		jobs := s.exe.List(req.GetParent(), sel)
+		for _, job := range jobs {
+			pb := codec.JobToJobStatePb(job)
+			if proto.Equal(pb, sent[job.Name]) {
+				continue
+			}
+			if err := srv.Send(pb); err != nil {
+				return err
+			}
+			sent[job.Name] = pb
+		}
+
+		// forget removed jobs
+		if len(sent) > len(jobs) {
+			clear(sent)
+			for _, job := range jobs {
+				sent[job.Name] = codec.JobToJobStatePb(job)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-changes:
+		}
+	}
+
+	return nil
+}
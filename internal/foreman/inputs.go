@@ -0,0 +1,106 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/inputs"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Inputs is the minimal interface needed to stage and claim job inputs.
+type Inputs interface {
+	Begin(owner string) (*inputs.Upload, error)
+	Claim(owner string, name string) (inputs.Staged, work.Inputs, error)
+}
+
+// UploadJobInputs implements foremanpb.ForemanServiceServer.
+func (s *Service) UploadJobInputs(srv grpc.ClientStreamingServer[foremanpb.UploadJobInputsRequest, foremanpb.JobInputs]) (err error) {
+	ctx := srv.Context()
+
+	var u *inputs.Upload
+	defer func() {
+		if err != nil && u != nil {
+			u.Abort()
+		}
+	}()
+
+	parent := ""
+	for {
+		req, err := srv.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if u == nil {
+			parent = req.GetParent()
+			if u, err = s.inputs.Begin(parent); err != nil {
+				return inputsError(ctx, err, parent)
+			}
+		} else if req.GetParent() != parent {
+			return status.Error(codes.InvalidArgument, "parent must not change during an upload")
+		}
+
+		if len(req.GetStdin()) > 0 {
+			if err := u.WriteStdin(req.GetStdin()); err != nil {
+				return inputsError(ctx, err, parent)
+			}
+		}
+		if req.GetPath() != "" {
+			if err := u.Write(req.GetPath(), fs.FileMode(req.GetMode()), req.GetData()); err != nil {
+				return inputsError(ctx, err, parent)
+			}
+		} else if len(req.GetData()) > 0 {
+			return status.Error(codes.InvalidArgument, "data requires a path")
+		}
+	}
+
+	if u == nil {
+		return status.Error(codes.InvalidArgument, "no inputs were sent")
+	}
+
+	st, err := u.Commit()
+	if err != nil {
+		return inputsError(ctx, err, parent)
+	}
+	return srv.SendAndClose(codec.JobInputsToPb(st))
+}
+
+// claimInputs moves the inputs named by req into job, so they can only be used once.
+func (s *Service) claimInputs(req startRequest, job *work.Job) error {
+	if req.GetInputs() == "" {
+		return nil
+	}
+
+	_, in, err := s.inputs.Claim(req.GetParent(), req.GetInputs())
+	if err != nil {
+		return status.Errorf(codes.NotFound, "no inputs found for '%v'", req.GetInputs())
+	}
+	job.Inputs = in
+	return nil
+}
+
+// inputsError maps errors from [Inputs] to gRPC status errors.
+func inputsError(ctx context.Context, err error, parent string) error {
+	var invalid *inputs.ErrInvalidPath
+	switch {
+	case errors.As(err, &invalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, inputs.ErrTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	slog.ErrorContext(ctx, "Failed to upload inputs", slog.String("parent", parent), slog.Any("error", err))
+	return status.Error(codes.Internal, "failed to upload inputs")
+}
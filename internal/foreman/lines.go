@@ -0,0 +1,148 @@
+package foreman
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/safe"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultFlushInterval is how long an incomplete line is held if the request does not set one.
+const defaultFlushInterval = time.Second
+
+// segmentRead is the result of a single [safe.NotifyingBufferReader.ReadSegment].
+type segmentRead struct {
+	data []byte
+	seg  safe.Segment
+	err  error
+}
+
+// pendingLine is an incomplete line of a single stream.
+type pendingLine struct {
+	data []byte
+	// time the first byte of data arrived.
+	time time.Time
+	// deadline is when data is sent as a partial line.
+	deadline time.Time
+}
+
+// watchLines streams output from r to srv one line at a time, until r is drained or ctx is done.
+// Incomplete lines are sent as partial lines once they are held for flush.
+//
+// r is closed before watchLines returns, so its reads never outlive the call.
+func watchLines(ctx context.Context, r *safe.NotifyingBufferReader, flush time.Duration, srv grpc.ServerStreamingServer[foremanpb.JobOutput]) error {
+	ctx, cancel := context.WithCancel(ctx)
+	reads := make(chan segmentRead)
+	exited := make(chan struct{})
+	defer func() {
+		// closing r unblocks a pending read, so the reader exits
+		cancel()
+		r.Close()
+		<-exited
+	}()
+
+	go func() {
+		defer close(exited)
+		defer close(reads)
+		for {
+			buf := make([]byte, 10240)
+			n, seg, err := r.ReadSegment(buf)
+			select {
+			case reads <- segmentRead{data: buf[:n], seg: seg, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	pending := make(map[safe.Stream]*pendingLine)
+	send := func(stream safe.Stream, p *pendingLine, partial bool) error {
+		delete(pending, stream)
+		return srv.Send(&foremanpb.JobOutput{
+			Data:    p.data,
+			Time:    timestamppb.New(p.time),
+			Stream:  codec.OutputStreamToPb(stream),
+			Partial: partial,
+		})
+	}
+
+	timer := time.NewTimer(flush)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case now := <-timer.C:
+			for stream, p := range pending {
+				if !now.Before(p.deadline) {
+					if err := send(stream, p, true); err != nil {
+						return err
+					}
+				}
+			}
+
+		case rd, ok := <-reads:
+			if !ok {
+				return nil
+			}
+
+			for data := rd.data; len(data) > 0; {
+				p, ok := pending[rd.seg.Stream]
+				if !ok {
+					p = &pendingLine{time: rd.seg.Time, deadline: time.Now().Add(flush)}
+					pending[rd.seg.Stream] = p
+				}
+
+				i := bytes.IndexByte(data, '\n')
+				if i < 0 {
+					p.data = append(p.data, data...)
+					break
+				}
+				p.data = append(p.data, data[:i+1]...)
+				data = data[i+1:]
+				if err := send(rd.seg.Stream, p, false); err != nil {
+					return err
+				}
+			}
+
+			if rd.err != nil {
+				// the job terminated, so incomplete lines will never be completed
+				for stream, p := range pending {
+					if err := send(stream, p, true); err != nil {
+						return err
+					}
+				}
+				if errors.Is(rd.err, io.EOF) {
+					return nil
+				}
+				return status.Errorf(codes.Internal, "failed to stream output: %v", rd.err)
+			}
+		}
+
+		// wake up for the oldest incomplete line
+		var next time.Time
+		for _, p := range pending {
+			if next.IsZero() || p.deadline.Before(next) {
+				next = p.deadline
+			}
+		}
+		if !next.IsZero() {
+			timer.Reset(time.Until(next))
+		}
+	}
+}
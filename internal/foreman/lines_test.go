@@ -0,0 +1,162 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/tests/utils"
+	"google.golang.org/grpc"
+)
+
+// fakeStream records the output sent by a server streaming RPC.
+type fakeStream struct {
+	grpc.ServerStream
+	mu   sync.Mutex
+	sent []*foremanpb.JobOutput
+	err  error
+}
+
+func (s *fakeStream) Send(out *foremanpb.JobOutput) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, out)
+	return s.err
+}
+
+// line is the part of a [foremanpb.JobOutput] that does not depend on timing.
+type line struct {
+	stream  foremanpb.OutputStream
+	data    string
+	partial bool
+}
+
+// write is a single write to the output of a job.
+type write struct {
+	stream safe.Stream
+	data   string
+}
+
+func Test_watchLines(t *testing.T) {
+	t.Parallel()
+	stdout, stderr := codec.OutputStreamToPb(safe.Stdout), codec.OutputStreamToPb(safe.Stderr)
+	long := strings.Repeat("x", 15000) + "\n"
+	tests := []struct {
+		name    string
+		writes  []write
+		close   bool
+		flush   time.Duration
+		sendErr error
+		want    []line
+		wantErr func(error) bool
+	}{
+		{
+			name:    "lines",
+			writes:  []write{{safe.Stdout, "a\nb\n"}},
+			close:   true,
+			flush:   time.Hour,
+			want:    []line{{stdout, "a\n", false}, {stdout, "b\n", false}},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "split across segments",
+			writes:  []write{{safe.Stdout, "hel"}, {safe.Stdout, "lo\nwor"}, {safe.Stdout, "ld\n"}},
+			close:   true,
+			flush:   time.Hour,
+			want:    []line{{stdout, "hello\n", false}, {stdout, "world\n", false}},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "split across reads",
+			writes:  []write{{safe.Stdout, long}},
+			close:   true,
+			flush:   time.Hour,
+			want:    []line{{stdout, long, false}},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "interleaved streams",
+			writes:  []write{{safe.Stdout, "out"}, {safe.Stderr, "err\n"}, {safe.Stdout, "put\n"}, {safe.Stderr, "more"}},
+			close:   true,
+			flush:   time.Hour,
+			want:    []line{{stderr, "err\n", false}, {stdout, "output\n", false}, {stderr, "more", true}},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "flush after timeout",
+			writes:  []write{{safe.Stdout, "prompt> "}},
+			flush:   10 * time.Millisecond,
+			want:    []line{{stdout, "prompt> ", true}},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "terminated with partial line",
+			writes:  []write{{safe.Stdout, "done\nno newline"}},
+			close:   true,
+			flush:   time.Hour,
+			want:    []line{{stdout, "done\n", false}, {stdout, "no newline", true}},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "cancelled with partial line",
+			writes:  []write{{safe.Stdout, "no newline"}},
+			flush:   time.Hour,
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "send failure",
+			writes:  []write{{safe.Stdout, "a\nb\n"}},
+			close:   true,
+			flush:   time.Hour,
+			sendErr: errors.New("testing error"),
+			want:    []line{{stdout, "a\n", false}},
+			wantErr: utils.ErrorTextContains(t, "testing error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			buf := safe.NewNotifyingBuffer()
+			for _, w := range tt.writes {
+				_, _ = buf.Writer(w.stream).Write([]byte(w.data))
+				// outlast segmentMerge, so every write is its own segment
+				time.Sleep(2 * time.Millisecond)
+			}
+			if tt.close {
+				_ = buf.Close()
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			r := buf.Reader()
+			srv := &fakeStream{err: tt.sendErr}
+			if err := watchLines(ctx, r, tt.flush, srv); !tt.wantErr(err) {
+				t.Fatalf("watchLines() error = %v", err)
+			}
+
+			// the reader is closed on return, even while it waits for output
+			if n, err := r.Read(make([]byte, 1)); n != 0 || !errors.Is(err, io.EOF) {
+				t.Errorf("watchLines() left the reader open, read %v bytes, error = %v", n, err)
+			}
+
+			var got []line
+			for _, out := range srv.sent {
+				got = append(got, line{out.GetStream(), string(out.GetData()), out.GetPartial()})
+				if out.GetTime() == nil {
+					t.Errorf("watchLines() sent %q without a time", out.GetData())
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("watchLines() sent %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PauseJob implements foremanpb.ForemanServiceServer.
+func (s *Service) PauseJob(ctx context.Context, req *foremanpb.PauseJobRequest) (*foremanpb.JobStatus, error) {
+	if err := s.exe.Pause(ctx, req.GetName()); err != nil {
+		return nil, pauseError(ctx, "pause", req.GetName(), err)
+	}
+	return s.jobStatus(req.GetName())
+}
+
+// ResumeJob implements foremanpb.ForemanServiceServer.
+func (s *Service) ResumeJob(ctx context.Context, req *foremanpb.ResumeJobRequest) (*foremanpb.JobStatus, error) {
+	if err := s.exe.Resume(ctx, req.GetName()); err != nil {
+		return nil, pauseError(ctx, "resume", req.GetName(), err)
+	}
+	return s.jobStatus(req.GetName())
+}
+
+// jobStatus returns the current status of the job with the given name.
+func (s *Service) jobStatus(name string) (*foremanpb.JobStatus, error) {
+	job, err := s.exe.Lookup(name)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no job found for '%v'", name)
+	}
+	return codec.JobToJobStatePb(job), nil
+}
+
+// pauseError maps an error from pausing or resuming a job to a gRPC status.
+func pauseError(ctx context.Context, op string, name string, err error) error {
+	var (
+		notFound *work.ErrJobNotFound
+		state    *work.ErrInvalidJobState
+	)
+	switch {
+	case errors.As(err, &notFound):
+		return status.Errorf(codes.NotFound, "no job found for '%v'", name)
+	case errors.As(err, &state):
+		return status.Errorf(codes.FailedPrecondition, "cannot %s job: %v", op, err)
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return status.FromContextError(err).Err()
+	}
+
+	slog.ErrorContext(ctx, "Failed to "+op+" job", slog.String("name", name), slog.Any("error", err))
+	return status.Errorf(codes.Internal, "failed to %s job", op)
+}
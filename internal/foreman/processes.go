@@ -0,0 +1,34 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListJobProcesses implements foremanpb.ForemanServiceServer.
+func (s *Service) ListJobProcesses(ctx context.Context, req *foremanpb.ListJobProcessesRequest) (*foremanpb.ListJobProcessesResponse, error) {
+	procs, err := s.exe.Processes(req.GetName())
+	if err != nil {
+		var (
+			notFound *work.ErrJobNotFound
+			state    *work.ErrInvalidJobState
+		)
+		switch {
+		case errors.As(err, &notFound):
+			return nil, status.Errorf(codes.NotFound, "no job found for '%v'", req.GetName())
+		case errors.As(err, &state):
+			return nil, status.Errorf(codes.FailedPrecondition, "job is not running: %v", err)
+		}
+		slog.ErrorContext(ctx, "Failed to list job processes", slog.String("name", req.GetName()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to list job processes")
+	}
+
+	return codec.JobProcessesToPb(procs), nil
+}
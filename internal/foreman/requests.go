@@ -0,0 +1,110 @@
+package foreman
+
+import (
+	"context"
+	"crypto/sha256"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// RequestIDWindow is how long a StartJob or StartJobFromTemplate request_id is remembered.
+// Retries within the window return the originally started Job.
+const RequestIDWindow = 15 * time.Minute
+
+// requestEntry is the result of a request with a request_id.
+type requestEntry struct {
+	name    string
+	digest  [sha256.Size]byte
+	expires time.Time
+}
+
+// requestCache remembers recent requests by request_id (https://google.aip.dev/155).
+// The zero value is ready for use. Callers of get and put must hold mu.
+type requestCache struct {
+	mu      sync.Mutex
+	entries map[string]requestEntry
+	// inflight holds a channel for each key of a request in progress, closed once it is done.
+	inflight map[string]chan struct{}
+}
+
+// acquire waits until no other request in progress holds any of keys, then holds them
+// until release is called, so only requests that may collide are serialized.
+// An error is returned if ctx is done first. Empty keys are ignored.
+// mu must not be held.
+func (c *requestCache) acquire(ctx context.Context, keys ...string) (release func(), err error) {
+	keys = slices.DeleteFunc(slices.Clone(keys), func(k string) bool { return k == "" })
+	for {
+		c.mu.Lock()
+		var busy chan struct{}
+		for _, k := range keys {
+			if ch, ok := c.inflight[k]; ok {
+				busy = ch
+				break
+			}
+		}
+		if busy == nil {
+			break
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-busy:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	defer c.mu.Unlock()
+
+	if c.inflight == nil {
+		c.inflight = make(map[string]chan struct{})
+	}
+	done := make(chan struct{})
+	for _, k := range keys {
+		c.inflight[k] = done
+	}
+
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, k := range keys {
+			delete(c.inflight, k)
+		}
+		close(done)
+	}, nil
+}
+
+// get returns the unexpired entry for key.
+func (c *requestCache) get(key string) (requestEntry, bool) {
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return requestEntry{}, false
+	}
+	return e, true
+}
+
+// put stores e for key until the [RequestIDWindow] elapses.
+// Expired entries are pruned.
+func (c *requestCache) put(key string, e requestEntry) {
+	now := time.Now()
+	if c.entries == nil {
+		c.entries = make(map[string]requestEntry)
+	}
+	for k, v := range c.entries {
+		if now.After(v.expires) {
+			delete(c.entries, k)
+		}
+	}
+
+	e.expires = now.Add(RequestIDWindow)
+	c.entries[key] = e
+}
+
+// requestDigest hashes req, so a reused request_id can be told apart
+// from a retry of the same request.
+func requestDigest(req proto.Message) [sha256.Size]byte {
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	return sha256.Sum256(b)
+}
@@ -0,0 +1,48 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func Test_requestCache_acquire(t *testing.T) {
+	t.Parallel()
+	var c requestCache
+	ctx := context.Background()
+
+	release, err := c.acquire(ctx, "request_id:a", "job:a")
+	if err != nil {
+		t.Fatalf("requestCache.acquire() error = %v", err)
+	}
+
+	// requests that cannot collide are not serialized
+	other, err := c.acquire(ctx, "request_id:b", "")
+	if err != nil {
+		t.Fatalf("requestCache.acquire() error = %v", err)
+	}
+	other()
+
+	// requests that may collide wait for the request in progress
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.acquire(timeout, "job:a"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("requestCache.acquire() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		if release, err := c.acquire(ctx, "job:a"); err == nil {
+			release()
+		}
+		close(acquired)
+	}()
+	release()
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Error("requestCache.acquire() did not return once the request in progress was done")
+	}
+}
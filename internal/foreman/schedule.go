@@ -0,0 +1,145 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/schedule"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Schedules is the minimal interface needed to manage schedules for Create/Get/List/Update/Delete.
+type Schedules interface {
+	Create(s schedule.Schedule) (schedule.Schedule, error)
+	Lookup(name string) (schedule.Schedule, error)
+	List(owner string) []schedule.Schedule
+	Update(s schedule.Schedule) (schedule.Schedule, error)
+	Delete(name string) error
+}
+
+// CreateSchedule implements foremanpb.ForemanServiceServer.
+func (s *Service) CreateSchedule(ctx context.Context, req *foremanpb.CreateScheduleRequest) (*foremanpb.Schedule, error) {
+	pb := req.GetSchedule()
+
+	sched := schedule.NewSchedule(req.GetParent(), pb.GetCron(), pb.GetCommand(), pb.GetArgs())
+	sched.TimeZone = pb.GetTimeZone()
+	sched.Concurrency = codec.ConcurrencyPolicyFromPb(pb.GetConcurrencyPolicy())
+	sched.SuccessfulHistoryLimit = int(pb.GetSuccessfulHistoryLimit())
+	sched.FailedHistoryLimit = int(pb.GetFailedHistoryLimit())
+
+	created, err := s.scheds.Create(*sched)
+	if err != nil {
+		return nil, scheduleError(ctx, err, sched.Name)
+	}
+
+	return codec.ScheduleToPb(created), nil
+}
+
+// GetSchedule implements foremanpb.ForemanServiceServer.
+func (s *Service) GetSchedule(ctx context.Context, req *foremanpb.GetScheduleRequest) (*foremanpb.Schedule, error) {
+	sched, err := s.scheds.Lookup(req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no schedule found for '%v'", req.GetName())
+	}
+	return codec.ScheduleToPb(sched), nil
+}
+
+// ListSchedules implements foremanpb.ForemanServiceServer.
+func (s *Service) ListSchedules(ctx context.Context, req *foremanpb.ListSchedulesRequest) (*foremanpb.ListSchedulesResponse, error) {
+	scheds := s.scheds.List(req.GetParent())
+
+	resp := &foremanpb.ListSchedulesResponse{Schedules: make([]*foremanpb.Schedule, 0, len(scheds))}
+	for _, sched := range scheds {
+		resp.Schedules = append(resp.Schedules, codec.ScheduleToPb(sched))
+	}
+	return resp, nil
+}
+
+// UpdateSchedule implements foremanpb.ForemanServiceServer.
+func (s *Service) UpdateSchedule(ctx context.Context, req *foremanpb.UpdateScheduleRequest) (*foremanpb.Schedule, error) {
+	pb := req.GetSchedule()
+
+	sched, err := s.scheds.Lookup(pb.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no schedule found for '%v'", pb.GetName())
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		// no mask means all populated fields (https://google.aip.dev/134#update-mask)
+		pb.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if fd.Name() != "name" {
+				paths = append(paths, string(fd.Name()))
+			}
+			return true
+		})
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "*":
+			sched.Cron = pb.GetCron()
+			sched.TimeZone = pb.GetTimeZone()
+			sched.Cmd = pb.GetCommand()
+			sched.Args = pb.GetArgs()
+			sched.Concurrency = codec.ConcurrencyPolicyFromPb(pb.GetConcurrencyPolicy())
+			sched.SuccessfulHistoryLimit = int(pb.GetSuccessfulHistoryLimit())
+			sched.FailedHistoryLimit = int(pb.GetFailedHistoryLimit())
+		case "cron":
+			sched.Cron = pb.GetCron()
+		case "time_zone":
+			sched.TimeZone = pb.GetTimeZone()
+		case "command":
+			sched.Cmd = pb.GetCommand()
+		case "args":
+			sched.Args = pb.GetArgs()
+		case "concurrency_policy":
+			sched.Concurrency = codec.ConcurrencyPolicyFromPb(pb.GetConcurrencyPolicy())
+		case "successful_history_limit":
+			sched.SuccessfulHistoryLimit = int(pb.GetSuccessfulHistoryLimit())
+		case "failed_history_limit":
+			sched.FailedHistoryLimit = int(pb.GetFailedHistoryLimit())
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "field '%s' cannot be updated", path)
+		}
+	}
+
+	updated, err := s.scheds.Update(sched)
+	if err != nil {
+		return nil, scheduleError(ctx, err, sched.Name)
+	}
+
+	return codec.ScheduleToPb(updated), nil
+}
+
+// DeleteSchedule implements foremanpb.ForemanServiceServer.
+func (s *Service) DeleteSchedule(ctx context.Context, req *foremanpb.DeleteScheduleRequest) (*emptypb.Empty, error) {
+	if err := s.scheds.Delete(req.GetName()); err != nil {
+		return nil, scheduleError(ctx, err, req.GetName())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// scheduleError maps errors from [Schedules] to gRPC status errors.
+func scheduleError(ctx context.Context, err error, name string) error {
+	var (
+		invalid  *schedule.ErrInvalidSchedule
+		notFound *schedule.ErrNotFound
+	)
+	switch {
+	case errors.As(err, &invalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &notFound):
+		return status.Errorf(codes.NotFound, "no schedule found for '%v'", name)
+	}
+
+	slog.ErrorContext(ctx, "Failed to manage schedule", slog.String("name", name), slog.Any("error", err))
+	return status.Error(codes.Internal, "failed to manage schedule")
+}
@@ -0,0 +1,41 @@
+package foreman
+
+import (
+	"errors"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/work"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchJobOutput implements foremanpb.ForemanServiceServer.
+func (s *Service) SearchJobOutput(req *foremanpb.SearchJobOutputRequest, srv grpc.ServerStreamingServer[foremanpb.OutputMatch]) error {
+	ctx := srv.Context()
+
+	err := s.exe.SearchOutput(ctx, req.GetName(), codec.SearchFromPb(req), func(m work.Match) error {
+		return srv.Send(codec.OutputMatchToPb(m))
+	})
+	if err == nil {
+		return nil
+	}
+
+	var (
+		invalid  *work.ErrInvalidSearch
+		notFound *work.ErrJobNotFound
+	)
+	switch {
+	case errors.As(err, &invalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &notFound):
+		return status.Errorf(codes.NotFound, "no job found for '%v'", req.GetName())
+	case ctx.Err() != nil:
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	slog.ErrorContext(ctx, "Failed to search job output", slog.String("name", req.GetName()), slog.Any("error", err))
+	return status.Error(codes.Internal, "failed to search job output")
+}
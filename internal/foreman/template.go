@@ -0,0 +1,113 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/template"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Templates is the minimal interface needed to manage job templates for Create/Get/List/Delete.
+type Templates interface {
+	Create(t template.Template) (template.Template, error)
+	Lookup(name string) (template.Template, error)
+	List(owner string) []template.Template
+	Delete(name string) error
+}
+
+// CreateJobTemplate implements foremanpb.ForemanServiceServer.
+func (s *Service) CreateJobTemplate(ctx context.Context, req *foremanpb.CreateJobTemplateRequest) (*foremanpb.JobTemplate, error) {
+	pb := req.GetJobTemplate()
+	if err := validateEnv(pb.GetEnv()); err != nil {
+		return nil, err
+	}
+
+	tmpl := codec.JobTemplateFromPb(req.GetParent(), pb)
+	for _, p := range pb.GetParameters() {
+		if _, ok := foremanpb.ParameterType_name[int32(p.GetType())]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "parameter '%s' has unknown type %v", p.GetName(), p.GetType())
+		}
+	}
+
+	created, err := s.templates.Create(*tmpl)
+	if err != nil {
+		return nil, templateError(ctx, err, tmpl.Name)
+	}
+
+	return codec.JobTemplateToPb(created), nil
+}
+
+// GetJobTemplate implements foremanpb.ForemanServiceServer.
+func (s *Service) GetJobTemplate(ctx context.Context, req *foremanpb.GetJobTemplateRequest) (*foremanpb.JobTemplate, error) {
+	tmpl, err := s.templates.Lookup(req.GetName())
+	if err != nil {
+		return nil, templateError(ctx, err, req.GetName())
+	}
+	return codec.JobTemplateToPb(tmpl), nil
+}
+
+// ListJobTemplates implements foremanpb.ForemanServiceServer.
+func (s *Service) ListJobTemplates(ctx context.Context, req *foremanpb.ListJobTemplatesRequest) (*foremanpb.ListJobTemplatesResponse, error) {
+	tmpls := s.templates.List(req.GetParent())
+
+	resp := &foremanpb.ListJobTemplatesResponse{JobTemplates: make([]*foremanpb.JobTemplate, 0, len(tmpls))}
+	for _, tmpl := range tmpls {
+		resp.JobTemplates = append(resp.JobTemplates, codec.JobTemplateToPb(tmpl))
+	}
+	return resp, nil
+}
+
+// DeleteJobTemplate implements foremanpb.ForemanServiceServer.
+func (s *Service) DeleteJobTemplate(ctx context.Context, req *foremanpb.DeleteJobTemplateRequest) (*emptypb.Empty, error) {
+	if err := s.templates.Delete(req.GetName()); err != nil {
+		return nil, templateError(ctx, err, req.GetName())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// StartJobFromTemplate implements foremanpb.ForemanServiceServer.
+func (s *Service) StartJobFromTemplate(ctx context.Context, req *foremanpb.StartJobFromTemplateRequest) (*foremanpb.JobResponse, error) {
+	if err := validateStart(req); err != nil {
+		return nil, err
+	}
+
+	tmpl, err := s.templates.Lookup(req.GetTemplate())
+	if err != nil {
+		return nil, templateError(ctx, err, req.GetTemplate())
+	}
+
+	job, err := newJob(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := tmpl.Apply(job, req.GetParameters()); err != nil {
+		return nil, templateError(ctx, err, tmpl.Name)
+	}
+
+	return s.startOnce(ctx, req, job)
+}
+
+// templateError maps errors from [Templates] to gRPC status errors.
+func templateError(ctx context.Context, err error, name string) error {
+	var (
+		invalid      *template.ErrInvalidTemplate
+		invalidParam *template.ErrInvalidParameter
+		notFound     *template.ErrNotFound
+	)
+	switch {
+	case errors.As(err, &invalid), errors.As(err, &invalidParam):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &notFound):
+		return status.Errorf(codes.NotFound, "no template found for '%v'", name)
+	}
+
+	slog.ErrorContext(ctx, "Failed to manage template", slog.String("name", name), slog.Any("error", err))
+	return status.Error(codes.Internal, "failed to manage template")
+}
@@ -0,0 +1,92 @@
+package foreman
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	foremanpb "github.com/drrev/telehandler/gen/drrev/telehandler/foreman/v1alpha1"
+	"github.com/drrev/telehandler/internal/codec"
+	"github.com/drrev/telehandler/pkg/workflow"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Workflows is the minimal interface needed to manage workflows for Create/Get/Stop/Watch.
+type Workflows interface {
+	Create(w workflow.Workflow) (workflow.Workflow, error)
+	Lookup(name string) (workflow.Workflow, error)
+	Stop(name string) error
+	Changes(name string) (<-chan struct{}, error)
+}
+
+// CreateWorkflow implements foremanpb.ForemanServiceServer.
+func (s *Service) CreateWorkflow(ctx context.Context, req *foremanpb.CreateWorkflowRequest) (*foremanpb.Workflow, error) {
+	steps := codec.StepsFromPb(req.GetWorkflow().GetSteps())
+
+	w, err := s.flows.Create(*workflow.NewWorkflow(req.GetParent(), steps))
+	if err != nil {
+		var invalid *workflow.ErrInvalidWorkflow
+		if errors.As(err, &invalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		slog.ErrorContext(ctx, "Failed to create workflow", slog.String("parent", req.GetParent()), slog.Any("error", err))
+		return nil, status.Error(codes.Internal, "failed to create workflow")
+	}
+
+	return codec.WorkflowToPb(w), nil
+}
+
+// GetWorkflow implements foremanpb.ForemanServiceServer.
+func (s *Service) GetWorkflow(ctx context.Context, req *foremanpb.GetWorkflowRequest) (*foremanpb.Workflow, error) {
+	w, err := s.flows.Lookup(req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no workflow found for '%v'", req.GetName())
+	}
+	return codec.WorkflowToPb(w), nil
+}
+
+// StopWorkflow implements foremanpb.ForemanServiceServer.
+func (s *Service) StopWorkflow(ctx context.Context, req *foremanpb.StopWorkflowRequest) (*emptypb.Empty, error) {
+	if err := s.flows.Stop(req.GetName()); err != nil {
+		slog.ErrorContext(ctx, "Failed to stop workflow", slog.String("name", req.GetName()))
+		return nil, status.Errorf(codes.Internal, "failed to stop workflow: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// WatchWorkflow implements foremanpb.ForemanServiceServer.
+func (s *Service) WatchWorkflow(req *foremanpb.WatchWorkflowRequest, srv grpc.ServerStreamingServer[foremanpb.Workflow]) error {
+	ctx := srv.Context()
+
+	for ctx.Err() == nil {
+		// grab the channel before the lookup, so no changes are missed
+		changes, err := s.flows.Changes(req.GetName())
+		if err != nil {
+			return status.Errorf(codes.NotFound, "no workflow found for '%v'", req.GetName())
+		}
+
+		w, err := s.flows.Lookup(req.GetName())
+		if err != nil {
+			return status.Errorf(codes.NotFound, "no workflow found for '%v'", req.GetName())
+		}
+
+		if err := srv.Send(codec.WorkflowToPb(w)); err != nil {
+			return err
+		}
+
+		if !w.Running() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-changes:
+		}
+	}
+
+	return nil
+}
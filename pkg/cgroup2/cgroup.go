@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 
 	"golang.org/x/sys/unix"
@@ -22,9 +23,43 @@ var (
 	}
 )
 
+const (
+	// cpuPeriod is the cpu.max period in microseconds.
+	cpuPeriod = 1_000_000
+	// memoryHighRatio is memory.high as a fraction of memory.max.
+	memoryHighRatio = 0.75
+)
+
+// Resources overrides the default CPU and memory constraints of a cgroup.
+// A zero value for any field keeps the default.
+type Resources struct {
+	// CPUMillis is the CPU bandwidth in millicores, where 1000 is one full CPU.
+	CPUMillis int64
+	// MemoryBytes is the hard memory limit. The memory.high throttling
+	// threshold is set to 75% of this value.
+	MemoryBytes int64
+}
+
+// constraints returns the default constraints with any overrides from r applied.
+func (r Resources) constraints() []Constraint {
+	out := slices.Clone(constraints)
+	for i, c := range out {
+		switch {
+		case c.FileName == "cpu.max" && r.CPUMillis > 0:
+			out[i].Value = fmt.Sprintf("%d %d", r.CPUMillis*cpuPeriod/1000, cpuPeriod)
+		case c.FileName == "memory.max" && r.MemoryBytes > 0:
+			out[i].Value = strconv.FormatInt(r.MemoryBytes, 10)
+		case c.FileName == "memory.high" && r.MemoryBytes > 0:
+			out[i].Value = strconv.FormatInt(int64(float64(r.MemoryBytes)*memoryHighRatio), 10)
+		}
+	}
+	return out
+}
+
 // Create a new cgroup v2 at the given base path.
-// CPU, memory, and IO constraints are automatically added.
-func Create(basePath string) (err error) {
+// CPU, memory, and IO constraints are automatically added,
+// using r to override the default CPU and memory limits.
+func Create(basePath string, r Resources) (err error) {
 	defer func() {
 		if err != nil {
 			os.Remove(basePath)
@@ -39,7 +74,7 @@ func Create(basePath string) (err error) {
 		return
 	}
 
-	return applyAllConstraints(basePath)
+	return applyAllConstraints(basePath, r.constraints())
 }
 
 // Cleanup removes the cgroup created at the given basePath.
@@ -47,8 +82,28 @@ func Cleanup(basePath string) error {
 	return os.Remove(basePath)
 }
 
-// applyAllConstraints applies constraints for cpu, memory, and io.
-func applyAllConstraints(root string) error {
+// Procs returns the PID of every process in the cgroup at the given basePath.
+// PIDs are in the PID namespace of the caller.
+func Procs(basePath string) ([]int, error) {
+	raw, err := os.ReadFile(filepath.Join(basePath, "cgroup.procs"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cgroup procs: %w", err)
+	}
+
+	fields := strings.Fields(string(raw))
+	pids := make([]int, 0, len(fields))
+	for _, f := range fields {
+		pid, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("malformed cgroup procs: %w", err)
+		}
+		pids = append(pids, pid)
+	}
+	return pids, nil
+}
+
+// applyAllConstraints applies constraints for cpu and memory, then io.
+func applyAllConstraints(root string, constraints []Constraint) error {
 	blockDeviceIter, err := blockDevices()
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package cgroup2
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -58,7 +59,7 @@ func Test_applyAllConstraints(t *testing.T) {
 		fp.Close()
 	}
 
-	if err := applyAllConstraints(tmp); err != nil {
+	if err := applyAllConstraints(tmp, constraints); err != nil {
 		t.Errorf("applyAllConstraints() unexpected error = %v", err)
 	}
 
@@ -91,3 +92,67 @@ func Test_applyAllConstraints(t *testing.T) {
 		}
 	}
 }
+
+func TestResources_constraints(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		r    Resources
+		want map[string]string
+	}{
+		{
+			name: "defaults",
+			want: map[string]string{"cpu.max": "100000 1000000", "memory.max": "512M", "memory.high": "384M", "memory.swap.max": "0"},
+		},
+		{
+			name: "cpu",
+			r:    Resources{CPUMillis: 1500},
+			want: map[string]string{"cpu.max": "1500000 1000000", "memory.max": "512M", "memory.high": "384M", "memory.swap.max": "0"},
+		},
+		{
+			name: "memory",
+			r:    Resources{MemoryBytes: 1 << 30},
+			want: map[string]string{"cpu.max": "100000 1000000", "memory.max": "1073741824", "memory.high": "805306368", "memory.swap.max": "0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.constraints()
+			if len(got) != len(tt.want) {
+				t.Fatalf("Resources.constraints() got %d constraints, want %d", len(got), len(tt.want))
+			}
+			for _, c := range got {
+				if c.Value != tt.want[c.FileName] {
+					t.Errorf("Resources.constraints() %s = %s, want %s", c.FileName, c.Value, tt.want[c.FileName])
+				}
+			}
+		})
+	}
+}
+
+func TestProcs(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+
+	if _, err := Procs(tmp); err == nil {
+		t.Errorf("Procs() should error if cgroup.procs is missing")
+	}
+
+	if err := os.WriteFile(filepath.Join(tmp, "cgroup.procs"), []byte("12\n345\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Procs(tmp)
+	if err != nil {
+		t.Fatalf("Procs() error = %v", err)
+	}
+	if !slices.Equal(got, []int{12, 345}) {
+		t.Errorf("Procs() = %v, want [12 345]", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(tmp, "cgroup.procs"), []byte("12\nabc\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Procs(tmp); err == nil {
+		t.Errorf("Procs() should error on malformed cgroup.procs")
+	}
+}
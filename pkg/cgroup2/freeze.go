@@ -0,0 +1,69 @@
+package cgroup2
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// eventsPollInterval is how often cgroup.events is read while waiting for a state change.
+const eventsPollInterval = 10 * time.Millisecond
+
+// Freeze stops every process in the cgroup at the given basePath,
+// then waits until the kernel reports the cgroup as frozen, or ctx is done.
+func Freeze(ctx context.Context, basePath string) error {
+	return setFrozen(ctx, basePath, "1")
+}
+
+// Thaw resumes every process in the cgroup at the given basePath,
+// then waits until the kernel reports the cgroup as thawed, or ctx is done.
+func Thaw(ctx context.Context, basePath string) error {
+	return setFrozen(ctx, basePath, "0")
+}
+
+// setFrozen writes v to cgroup.freeze, then waits for the frozen event to match.
+func setFrozen(ctx context.Context, basePath string, v string) error {
+	if err := os.WriteFile(filepath.Join(basePath, "cgroup.freeze"), []byte(v), 0); err != nil {
+		return fmt.Errorf("failed to write cgroup freeze: %w", err)
+	}
+	return waitEvent(ctx, basePath, "frozen", v)
+}
+
+// waitEvent polls cgroup.events until key has the value want, or ctx is done.
+func waitEvent(ctx context.Context, basePath string, key string, want string) error {
+	t := time.NewTicker(eventsPollInterval)
+	defer t.Stop()
+
+	for {
+		raw, err := os.ReadFile(filepath.Join(basePath, "cgroup.events"))
+		if err != nil {
+			return fmt.Errorf("failed to read cgroup events: %w", err)
+		}
+		if v, ok := eventValue(raw, key); ok && v == want {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("cgroup did not report %s %s: %w", key, want, ctx.Err())
+		case <-t.C:
+		}
+	}
+}
+
+// eventValue finds the value of key in the contents of cgroup.events,
+// which holds one space separated key and value per line.
+func eventValue(raw []byte, key string) (string, bool) {
+	s := bufio.NewScanner(bytes.NewReader(raw))
+	for s.Scan() {
+		if k, v, ok := strings.Cut(s.Text(), " "); ok && k == key {
+			return v, true
+		}
+	}
+	return "", false
+}
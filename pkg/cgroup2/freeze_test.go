@@ -0,0 +1,58 @@
+package cgroup2
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFreeze(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+
+	if err := Freeze(context.Background(), filepath.Join(tmp, "missing")); err == nil {
+		t.Errorf("Freeze() should error if the cgroup does not exist")
+	}
+
+	// fake a cgroup the kernel reports as frozen
+	if err := os.WriteFile(filepath.Join(tmp, "cgroup.freeze"), []byte("0"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "cgroup.events"), []byte("populated 1\nfrozen 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Freeze(context.Background(), tmp); err != nil {
+		t.Errorf("Freeze() error = %v", err)
+	}
+	if raw, _ := os.ReadFile(filepath.Join(tmp, "cgroup.freeze")); string(raw) != "1" {
+		t.Errorf("Freeze() wrote cgroup.freeze = %q, want %q", raw, "1")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := Thaw(ctx, tmp); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Thaw() error = %v, want context.DeadlineExceeded while still frozen", err)
+	}
+	if raw, _ := os.ReadFile(filepath.Join(tmp, "cgroup.freeze")); string(raw) != "0" {
+		t.Errorf("Thaw() wrote cgroup.freeze = %q, want %q", raw, "0")
+	}
+}
+
+func Test_eventValue(t *testing.T) {
+	t.Parallel()
+	raw := []byte("populated 0\nfrozen 1\n")
+
+	if v, ok := eventValue(raw, "frozen"); !ok || v != "1" {
+		t.Errorf("eventValue(frozen) = %q, %v, want %q, true", v, ok, "1")
+	}
+	if v, ok := eventValue(raw, "populated"); !ok || v != "0" {
+		t.Errorf("eventValue(populated) = %q, %v, want %q, true", v, ok, "0")
+	}
+	if _, ok := eventValue(raw, "missing"); ok {
+		t.Errorf("eventValue(missing) should not be found")
+	}
+}
@@ -0,0 +1,81 @@
+package cgroup2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// Signal sends sig to every process in the cgroup at the given basePath.
+// Processes that exit before they are signaled are ignored.
+func Signal(basePath string, sig unix.Signal) error {
+	pids, err := Procs(basePath)
+	if err != nil {
+		return err
+	}
+	for _, pid := range pids {
+		if err := unix.Kill(pid, sig); err != nil && !errors.Is(err, unix.ESRCH) {
+			return fmt.Errorf("failed to signal process %d: %w", pid, err)
+		}
+	}
+	return nil
+}
+
+// WaitEmpty waits until no process is left in the cgroup at the given basePath,
+// or ctx is done.
+func WaitEmpty(ctx context.Context, basePath string) error {
+	return waitEvent(ctx, basePath, "populated", "0")
+}
+
+// Kill sends SIGKILL to every process in the cgroup at the given basePath,
+// including processes that left the process group or ignore other signals,
+// then waits until the cgroup is empty, or ctx is done.
+//
+// cgroup.kill is used if the kernel supports it (Linux 5.14). Otherwise, every
+// process in cgroup.procs is killed until the cgroup is empty, which also catches
+// processes forked while the cgroup is being killed.
+func Kill(ctx context.Context, basePath string) error {
+	f, err := os.OpenFile(filepath.Join(basePath, "cgroup.kill"), os.O_WRONLY, 0)
+	if err == nil {
+		_, err = f.Write([]byte("1"))
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write cgroup kill: %w", err)
+		}
+		return WaitEmpty(ctx, basePath)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to open cgroup kill: %w", err)
+	}
+
+	t := time.NewTicker(eventsPollInterval)
+	defer t.Stop()
+
+	for {
+		raw, err := os.ReadFile(filepath.Join(basePath, "cgroup.events"))
+		if err != nil {
+			return fmt.Errorf("failed to read cgroup events: %w", err)
+		}
+		if v, _ := eventValue(raw, "populated"); v == "0" {
+			return nil
+		}
+
+		if err := Signal(basePath, unix.SIGKILL); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("cgroup did not report populated 0: %w", ctx.Err())
+		case <-t.C:
+		}
+	}
+}
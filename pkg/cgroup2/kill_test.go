@@ -0,0 +1,71 @@
+package cgroup2
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestKill(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+
+	if err := Kill(context.Background(), tmp); err == nil {
+		t.Errorf("Kill() should error if cgroup.events is missing")
+	}
+
+	// fake a cgroup without cgroup.kill, holding a single process
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	waited := make(chan error, 1)
+	go func() { waited <- cmd.Wait() }()
+
+	if err := os.WriteFile(filepath.Join(tmp, "cgroup.procs"), []byte(strconv.Itoa(cmd.Process.Pid)+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "cgroup.events"), []byte("populated 1\nfrozen 0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := Kill(ctx, tmp); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Kill() error = %v, want context.DeadlineExceeded while still populated", err)
+	}
+
+	select {
+	case err := <-waited:
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.Sys().(syscall.WaitStatus).Signal() != syscall.SIGKILL {
+			t.Errorf("process exited with %v, want SIGKILL", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Kill() did not kill the process")
+	}
+
+	if err := os.WriteFile(filepath.Join(tmp, "cgroup.events"), []byte("populated 0\nfrozen 0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Kill(context.Background(), tmp); err != nil {
+		t.Errorf("Kill() error = %v", err)
+	}
+
+	// cgroup.kill is preferred if the kernel supports it
+	if err := os.WriteFile(filepath.Join(tmp, "cgroup.kill"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Kill(context.Background(), tmp); err != nil {
+		t.Errorf("Kill() error = %v", err)
+	}
+	if raw, _ := os.ReadFile(filepath.Join(tmp, "cgroup.kill")); string(raw) != "1" {
+		t.Errorf("Kill() wrote cgroup.kill = %q, want %q", raw, "1")
+	}
+}
@@ -0,0 +1,10 @@
+// Package image stores the filesystems of OCI images for [work.Job]s to run in.
+//
+// Images are imported from tarballs in the OCI image layout, such as those written by
+// `docker save` or `skopeo copy ... oci-archive:`, so no registry is needed. Every layer
+// is verified, then unpacked once into a directory named after its digest, which is
+// shared by every image that contains it. The directories are stacked with overlayfs,
+// so layers use the overlayfs format for whiteouts.
+//
+// All images are managed by the [Store].
+package image
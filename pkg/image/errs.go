@@ -0,0 +1,32 @@
+package image
+
+import "fmt"
+
+func invalidImage(reason string) *ErrInvalidImage {
+	return &ErrInvalidImage{reason}
+}
+
+// ErrInvalidImage is returned if an imported tarball is not a valid OCI image layout,
+// or it has no image that can run on this host.
+type ErrInvalidImage struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidImage) Error() string {
+	return fmt.Sprintf("invalid image: %s", e.reason)
+}
+
+func imageNotFound(name string) *ErrNotFound {
+	return &ErrNotFound{name}
+}
+
+// ErrNotFound is returned if no image is found for a given name.
+type ErrNotFound struct {
+	name string
+}
+
+// Error implements error.
+func (e *ErrNotFound) Error() string {
+	return fmt.Sprintf("no image found with name='%v'", e.name)
+}
@@ -0,0 +1,134 @@
+package image
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Image is an imported OCI image.
+type Image struct {
+	// Name is the reference of the image in the imported tarball, such as
+	// "docker.io/library/alpine:3.20", or its Digest if it had none.
+	Name string
+	// Digest of the image manifest.
+	Digest string
+	// Layers lists the digests of the layers of the image, base layer first.
+	Layers []string
+	// Env lists the default KEY=VALUE environment variables of the image.
+	Env []string
+	// Entrypoint is the default executable, and its leading args.
+	Entrypoint []string
+	// Cmd lists the default args passed to the Entrypoint, or the default command
+	// if there is no Entrypoint.
+	Cmd []string
+	// WorkingDir is the default working directory of the command. Empty if unset.
+	WorkingDir string
+	// ImportTime of when the image was imported.
+	ImportTime time.Time
+}
+
+// Command returns the executable and args of a command that runs in img, in the manner of Docker.
+// Unless empty, args replace the Cmd of the image, and are appended to its Entrypoint.
+// Returns false if neither the image nor args name an executable.
+func (img Image) Command(args []string) (string, []string, bool) {
+	if len(args) == 0 {
+		args = img.Cmd
+	}
+	argv := append(append([]string{}, img.Entrypoint...), args...)
+	if len(argv) == 0 || argv[0] == "" {
+		return "", nil, false
+	}
+	return argv[0], argv[1:], true
+}
+
+// LogValue implements slog.LogValuer.
+func (img Image) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", img.Name),
+		slog.String("digest", img.Digest),
+		slog.Int("layers", len(img.Layers)),
+	)
+}
+
+// Media types of the OCI image spec, and the Docker equivalents written by older tools.
+// See: https://github.com/opencontainers/image-spec/blob/main/media-types.md
+const (
+	mediaTypeIndex          = "application/vnd.oci.image.index.v1+json"
+	mediaTypeManifest       = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerList     = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+// Annotations that name an image in the index of an image layout.
+const (
+	annotationContainerdName = "io.containerd.image.name"
+	annotationRefName        = "org.opencontainers.image.ref.name"
+)
+
+// layoutVersion is the only supported version of the oci-layout file.
+const layoutVersion = "1.0.0"
+
+// descriptor references a blob of an image layout.
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Platform    *platform         `json:"platform,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// name returns the reference of the image described by d, if it has one.
+func (d descriptor) name() string {
+	if name := d.Annotations[annotationContainerdName]; name != "" {
+		return name
+	}
+	return d.Annotations[annotationRefName]
+}
+
+type platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+type layout struct {
+	ImageLayoutVersion string `json:"imageLayoutVersion"`
+}
+
+// index is an image index, which is also the format of index.json.
+type index struct {
+	MediaType string       `json:"mediaType"`
+	Manifests []descriptor `json:"manifests"`
+}
+
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Config    descriptor   `json:"config"`
+	Layers    []descriptor `json:"layers"`
+}
+
+// config is the subset of the image configuration used to run a command.
+type config struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Config       struct {
+		Env        []string `json:"Env"`
+		Entrypoint []string `json:"Entrypoint"`
+		Cmd        []string `json:"Cmd"`
+		WorkingDir string   `json:"WorkingDir"`
+	} `json:"config"`
+	RootFS struct {
+		Type    string   `json:"type"`
+		DiffIDs []string `json:"diff_ids"`
+	} `json:"rootfs"`
+}
+
+// isIndex returns true if mediaType is an index of manifests for several platforms.
+func isIndex(mediaType string) bool {
+	return mediaType == mediaTypeIndex || mediaType == mediaTypeDockerList
+}
+
+// isGzip returns true if mediaType is a gzip compressed layer.
+func isGzip(mediaType string) bool {
+	return strings.HasSuffix(mediaType, "+gzip") || strings.HasSuffix(mediaType, ".tar.gzip")
+}
@@ -0,0 +1,428 @@
+package image
+
+import (
+	"archive/tar"
+	"cmp"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Store is a thread-safe [Image] store, kept in a directory on the host.
+// Images and layers are written with a rename, so a Store may be shared
+// with other processes, such as an import run while the server is running.
+//
+// See [NewStore].
+type Store struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewStore creates a [Store] in dir, creating the directory if needed.
+func NewStore(dir string) (*Store, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range []string{"images", filepath.Join("layers", "sha256"), "tmp"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create image store: %w", err)
+		}
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Import reads a tarball in the OCI image layout from r, and stores every image it
+// names. Images for several platforms are resolved to the manifest for this host.
+// An image replaces any stored image with the same name. Layers that are already
+// stored are not unpacked again.
+//
+// [ErrInvalidImage] is returned if the tarball is malformed, a blob does not match
+// its digest, or an image cannot run on this host.
+func (s *Store) Import(r io.Reader) ([]Image, error) {
+	tmp, err := os.MkdirTemp(filepath.Join(s.dir, "tmp"), "import-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := extractLayout(tmp, r); err != nil {
+		return nil, err
+	}
+
+	var l layout
+	if err := readLayoutFile(tmp, "oci-layout", &l); err != nil {
+		return nil, err
+	}
+	if l.ImageLayoutVersion != layoutVersion {
+		return nil, invalidImage(fmt.Sprintf("unsupported image layout version '%s'", l.ImageLayoutVersion))
+	}
+	var idx index
+	if err := readLayoutFile(tmp, "index.json", &idx); err != nil {
+		return nil, err
+	}
+	if len(idx.Manifests) == 0 {
+		return nil, invalidImage("index.json has no images")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	images := make([]Image, 0, len(idx.Manifests))
+	for _, desc := range idx.Manifests {
+		img, err := s.importImage(tmp, desc)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, img)
+	}
+
+	// stored last, so no image references a layer that is not unpacked
+	for _, img := range images {
+		if err := s.put(img); err != nil {
+			return nil, err
+		}
+		slog.Info("Image imported", slog.Any("image", img.LogValue()))
+	}
+	return images, nil
+}
+
+// importImage resolves desc to the manifest for this host, then unpacks every layer it lists.
+func (s *Store) importImage(layoutDir string, desc descriptor) (Image, error) {
+	name := cmp.Or(desc.name(), desc.Digest)
+
+	if isIndex(desc.MediaType) {
+		var idx index
+		if err := readBlob(layoutDir, desc, &idx); err != nil {
+			return Image{}, err
+		}
+		i := slices.IndexFunc(idx.Manifests, func(d descriptor) bool {
+			return d.Platform != nil && d.Platform.OS == runtime.GOOS && d.Platform.Architecture == runtime.GOARCH
+		})
+		if i < 0 {
+			return Image{}, invalidImage(fmt.Sprintf("image '%s' has no manifest for %s/%s", name, runtime.GOOS, runtime.GOARCH))
+		}
+		desc = idx.Manifests[i]
+	}
+	if desc.MediaType != mediaTypeManifest && desc.MediaType != mediaTypeDockerManifest {
+		return Image{}, invalidImage(fmt.Sprintf("image '%s' has unsupported media type '%s'", name, desc.MediaType))
+	}
+
+	var m manifest
+	if err := readBlob(layoutDir, desc, &m); err != nil {
+		return Image{}, err
+	}
+	var c config
+	if err := readBlob(layoutDir, m.Config, &c); err != nil {
+		return Image{}, err
+	}
+	if c.OS != runtime.GOOS || c.Architecture != runtime.GOARCH {
+		return Image{}, invalidImage(fmt.Sprintf("image '%s' is for %s/%s, not %s/%s", name, c.OS, c.Architecture, runtime.GOOS, runtime.GOARCH))
+	}
+	if len(c.RootFS.DiffIDs) != len(m.Layers) {
+		return Image{}, invalidImage(fmt.Sprintf("image '%s' has %d layers, but %d diff_ids", name, len(m.Layers), len(c.RootFS.DiffIDs)))
+	}
+
+	img := Image{
+		Name:       name,
+		Digest:     desc.Digest,
+		Env:        c.Config.Env,
+		Entrypoint: c.Config.Entrypoint,
+		Cmd:        c.Config.Cmd,
+		WorkingDir: c.Config.WorkingDir,
+		ImportTime: time.Now(),
+	}
+	for i, layer := range m.Layers {
+		if err := s.importLayer(layoutDir, layer, c.RootFS.DiffIDs[i]); err != nil {
+			return Image{}, err
+		}
+		img.Layers = append(img.Layers, layer.Digest)
+	}
+	return img, nil
+}
+
+// importLayer unpacks the layer described by desc, unless it is already stored.
+// The uncompressed layer must match diffID.
+func (s *Store) importLayer(layoutDir string, desc descriptor, diffID string) error {
+	dir, err := s.layerDir(desc.Digest)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(dir); err == nil {
+		return nil
+	}
+	if strings.HasSuffix(desc.MediaType, "+zstd") {
+		return invalidImage(fmt.Sprintf("layer %s has unsupported media type '%s'", desc.Digest, desc.MediaType))
+	}
+
+	f, err := openBlob(layoutDir, desc)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if isGzip(desc.MediaType) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return invalidImage(fmt.Sprintf("layer %s is not gzip compressed: %v", desc.Digest, err))
+		}
+		defer gz.Close()
+		r = gz
+	}
+	h := sha256.New()
+	r = io.TeeReader(r, h)
+
+	tmp, err := os.MkdirTemp(filepath.Join(s.dir, "tmp"), "layer-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := unpackLayer(tmp, r); err != nil {
+		return fmt.Errorf("failed to unpack layer %s: %w", desc.Digest, err)
+	}
+	// the tar format may be followed by padding, which is part of the diff_id
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return fmt.Errorf("failed to unpack layer %s: %w", desc.Digest, err)
+	}
+	if got := "sha256:" + hex.EncodeToString(h.Sum(nil)); got != diffID {
+		return invalidImage(fmt.Sprintf("layer %s has diff_id %s, want %s", desc.Digest, got, diffID))
+	}
+
+	if err := os.Rename(tmp, dir); err != nil {
+		// another process unpacked the layer first
+		if _, serr := os.Stat(dir); serr != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the [Image] with the given name, or manifest digest.
+//
+// [ErrNotFound] is returned if no such image is stored.
+func (s *Store) Get(name string) (Image, error) {
+	var img Image
+	err := readJSON(s.imagePath(name), &img)
+	if err == nil {
+		return img, nil
+	}
+	if strings.HasPrefix(name, "sha256:") {
+		images, lerr := s.List()
+		if lerr != nil {
+			return Image{}, lerr
+		}
+		if i := slices.IndexFunc(images, func(img Image) bool { return img.Digest == name }); i >= 0 {
+			return images[i], nil
+		}
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return Image{}, imageNotFound(name)
+	}
+	return Image{}, err
+}
+
+// List returns every stored [Image], sorted by name.
+func (s *Store) List() ([]Image, error) {
+	entries, err := os.ReadDir(filepath.Join(s.dir, "images"))
+	if err != nil {
+		return nil, err
+	}
+	images := make([]Image, 0, len(entries))
+	for _, e := range entries {
+		var img Image
+		if err := readJSON(filepath.Join(s.dir, "images", e.Name()), &img); err != nil {
+			// removed, or being written by another process
+			continue
+		}
+		images = append(images, img)
+	}
+	slices.SortFunc(images, func(a, b Image) int { return cmp.Compare(a.Name, b.Name) })
+	return images, nil
+}
+
+// LayerDirs returns the directories of the unpacked layers of img, topmost layer first,
+// which is the order of the lowerdir option of overlayfs.
+func (s *Store) LayerDirs(img Image) ([]string, error) {
+	dirs := make([]string, 0, len(img.Layers))
+	for i := len(img.Layers) - 1; i >= 0; i-- {
+		dir, err := s.layerDir(img.Layers[i])
+		if err != nil {
+			return nil, err
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs, nil
+}
+
+// put stores img, replacing any image with the same name.
+func (s *Store) put(img Image) error {
+	data, err := json.Marshal(img)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Join(s.dir, "tmp"), "image-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.imagePath(img.Name))
+}
+
+// imagePath returns the path of the record of the image with the given name.
+// Names contain slashes and colons, so the file is named after their hash.
+func (s *Store) imagePath(name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return filepath.Join(s.dir, "images", hex.EncodeToString(sum[:])+".json")
+}
+
+// layerDir returns the directory of the unpacked layer with the given digest.
+func (s *Store) layerDir(digest string) (string, error) {
+	hexDigest, err := parseDigest(digest)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, "layers", "sha256", hexDigest), nil
+}
+
+// parseDigest returns the hex encoded hash of a sha256 digest.
+func parseDigest(digest string) (string, error) {
+	alg, encoded, ok := strings.Cut(digest, ":")
+	if !ok || alg != "sha256" {
+		return "", invalidImage(fmt.Sprintf("unsupported digest '%s'", digest))
+	}
+	if b, err := hex.DecodeString(encoded); err != nil || len(b) != sha256.Size || strings.ToLower(encoded) != encoded {
+		return "", invalidImage(fmt.Sprintf("malformed digest '%s'", digest))
+	}
+	return encoded, nil
+}
+
+// openBlob opens the blob described by desc in layoutDir, once it is verified against its digest.
+func openBlob(layoutDir string, desc descriptor) (*os.File, error) {
+	hexDigest, err := parseDigest(desc.Digest)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(layoutDir, "blobs", "sha256", hexDigest))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, invalidImage(fmt.Sprintf("blob %s is missing", desc.Digest))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	switch {
+	case err != nil:
+		f.Close()
+		return nil, err
+	case hex.EncodeToString(h.Sum(nil)) != hexDigest:
+		f.Close()
+		return nil, invalidImage(fmt.Sprintf("blob %s does not match its digest", desc.Digest))
+	case desc.Size > 0 && n != desc.Size:
+		f.Close()
+		return nil, invalidImage(fmt.Sprintf("blob %s has %d bytes, want %d", desc.Digest, n, desc.Size))
+	}
+	return f, nil
+}
+
+// readBlob decodes the JSON blob described by desc in layoutDir into v.
+func readBlob(layoutDir string, desc descriptor, v any) error {
+	f, err := openBlob(layoutDir, desc)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := json.NewDecoder(f).Decode(v); err != nil {
+		return invalidImage(fmt.Sprintf("malformed blob %s: %v", desc.Digest, err))
+	}
+	return nil
+}
+
+// readLayoutFile decodes the JSON file name at the root of layoutDir into v.
+func readLayoutFile(layoutDir string, name string, v any) error {
+	err := readJSON(filepath.Join(layoutDir, name), v)
+	if errors.Is(err, fs.ErrNotExist) {
+		return invalidImage(fmt.Sprintf("not an OCI image layout, %s is missing", name))
+	}
+	return err
+}
+
+// readJSON decodes the JSON file p into v.
+func readJSON(p string, v any) error {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return invalidImage(fmt.Sprintf("malformed %s: %v", filepath.Base(p), err))
+	}
+	return nil
+}
+
+// extractLayout extracts the image layout tarball r into dir.
+// Only the regular files and directories of the layout are extracted.
+func extractLayout(dir string, r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return invalidImage(fmt.Sprintf("malformed tarball: %v", err))
+		}
+
+		name := filepath.Clean(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return invalidImage(fmt.Sprintf("tarball entry '%s' is not relative to the image layout", hdr.Name))
+		}
+		p := filepath.Join(dir, name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(p, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(p, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,371 @@
+package image
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+	"golang.org/x/sys/unix"
+)
+
+// entry is a file in a test tarball. Directories end with a slash.
+type entry struct {
+	name     string
+	data     string
+	linkname string
+	typeflag byte
+}
+
+func makeTar(t *testing.T, entries []entry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.data)), Linkname: e.linkname, Typeflag: e.typeflag}
+		switch {
+		case e.typeflag != 0:
+			hdr.Size = 0
+		case e.name[len(e.name)-1] == '/':
+			hdr.Typeflag, hdr.Mode = tar.TypeDir, 0o755
+		default:
+			hdr.Typeflag = tar.TypeReg
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			if _, err := tw.Write([]byte(e.data)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// layoutBuilder writes blobs of a test image layout.
+type layoutBuilder struct {
+	t     *testing.T
+	blobs map[string][]byte
+}
+
+func (b *layoutBuilder) blob(mediaType string, data []byte) descriptor {
+	d := digest(data)
+	b.blobs[d] = data
+	return descriptor{MediaType: mediaType, Digest: d, Size: int64(len(data))}
+}
+
+func (b *layoutBuilder) json(mediaType string, v any) descriptor {
+	data, err := json.Marshal(v)
+	if err != nil {
+		b.t.Fatal(err)
+	}
+	return b.blob(mediaType, data)
+}
+
+// image adds an image with the given layers, which are gzip compressed, and returns its manifest.
+func (b *layoutBuilder) image(arch string, layers ...[]byte) descriptor {
+	var c config
+	c.OS, c.Architecture = "linux", arch
+	c.Config.Env = []string{"PATH=/bin"}
+	c.Config.Entrypoint = []string{"/bin/app"}
+	c.Config.Cmd = []string{"--serve"}
+	c.Config.WorkingDir = "/srv"
+	c.RootFS.Type = "layers"
+
+	m := manifest{MediaType: mediaTypeManifest}
+	for _, layer := range layers {
+		var gz bytes.Buffer
+		w := gzip.NewWriter(&gz)
+		if _, err := w.Write(layer); err != nil {
+			b.t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			b.t.Fatal(err)
+		}
+		c.RootFS.DiffIDs = append(c.RootFS.DiffIDs, digest(layer))
+		m.Layers = append(m.Layers, b.blob("application/vnd.oci.image.layer.v1.tar+gzip", gz.Bytes()))
+	}
+	m.Config = b.json("application/vnd.oci.image.config.v1+json", c)
+	return b.json(mediaTypeManifest, m)
+}
+
+// tarball returns the image layout as a tarball, with an index of manifests.
+func (b *layoutBuilder) tarball(manifests ...descriptor) []byte {
+	idx, err := json.Marshal(index{MediaType: mediaTypeIndex, Manifests: manifests})
+	if err != nil {
+		b.t.Fatal(err)
+	}
+	entries := []entry{
+		{name: "oci-layout", data: `{"imageLayoutVersion":"1.0.0"}`},
+		{name: "index.json", data: string(idx)},
+		{name: "blobs/"},
+		{name: "blobs/sha256/"},
+	}
+	for d, data := range b.blobs {
+		entries = append(entries, entry{name: "blobs/sha256/" + d[len("sha256:"):], data: string(data)})
+	}
+	return makeTar(b.t, entries)
+}
+
+func named(d descriptor, name string) descriptor {
+	d.Annotations = map[string]string{annotationRefName: name}
+	return d
+}
+
+func TestStore_Import(t *testing.T) {
+	t.Parallel()
+
+	base := makeTar(t, []entry{
+		{name: "bin/"},
+		{name: "bin/app", data: "app"},
+		{name: "etc/"},
+		{name: "etc/passwd", data: "root"},
+		{name: "etc/hosts", linkname: "etc/passwd", typeflag: tar.TypeLink},
+		{name: "lib", linkname: "/usr/lib", typeflag: tar.TypeSymlink},
+	})
+
+	tests := []struct {
+		name    string
+		tarball func(b *layoutBuilder) []byte
+		want    []string
+		wantErr func(error) bool
+	}{
+		{
+			name: "layers",
+			tarball: func(b *layoutBuilder) []byte {
+				return b.tarball(named(b.image(runtime.GOARCH, base), "app:1"))
+			},
+			want:    []string{"app:1"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name: "unnamed",
+			tarball: func(b *layoutBuilder) []byte {
+				return b.tarball(b.image(runtime.GOARCH, base))
+			},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name: "platform index",
+			tarball: func(b *layoutBuilder) []byte {
+				other, host := b.image("s390x", base), b.image(runtime.GOARCH, base)
+				other.Platform = &platform{OS: "linux", Architecture: "s390x"}
+				host.Platform = &platform{OS: "linux", Architecture: runtime.GOARCH}
+				return b.tarball(named(b.json(mediaTypeIndex, index{Manifests: []descriptor{other, host}}), "app:1"))
+			},
+			want:    []string{"app:1"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name: "no platform",
+			tarball: func(b *layoutBuilder) []byte {
+				other := b.image("s390x", base)
+				other.Platform = &platform{OS: "linux", Architecture: "s390x"}
+				return b.tarball(named(b.json(mediaTypeIndex, index{Manifests: []descriptor{other}}), "app:1"))
+			},
+			wantErr: utils.ErrorTextContains(t, "has no manifest for linux/"+runtime.GOARCH),
+		},
+		{
+			name: "wrong architecture",
+			tarball: func(b *layoutBuilder) []byte {
+				return b.tarball(named(b.image("s390x", base), "app:1"))
+			},
+			wantErr: utils.ErrorTextContains(t, "is for linux/s390x"),
+		},
+		{
+			name: "digest mismatch",
+			tarball: func(b *layoutBuilder) []byte {
+				d := b.image(runtime.GOARCH, base)
+				b.blobs[d.Digest] = append(b.blobs[d.Digest], ' ')
+				return b.tarball(d)
+			},
+			wantErr: utils.ErrorTextContains(t, "does not match its digest"),
+		},
+		{
+			name: "missing blob",
+			tarball: func(b *layoutBuilder) []byte {
+				d := b.image(runtime.GOARCH, base)
+				delete(b.blobs, d.Digest)
+				return b.tarball(d)
+			},
+			wantErr: utils.ErrorTextContains(t, "is missing"),
+		},
+		{
+			name: "symlink parent",
+			tarball: func(b *layoutBuilder) []byte {
+				return b.tarball(b.image(runtime.GOARCH, makeTar(t, []entry{
+					{name: "lib", linkname: "/etc", typeflag: tar.TypeSymlink},
+					{name: "lib/passwd", data: "x"},
+				})))
+			},
+			wantErr: utils.ErrorTextContains(t, "'lib' is not a directory in its layer"),
+		},
+		{
+			name: "not a layout",
+			tarball: func(b *layoutBuilder) []byte {
+				return makeTar(t, []entry{{name: "manifest.json", data: "[]"}})
+			},
+			wantErr: utils.ErrorTextContains(t, "not an OCI image layout, oci-layout is missing"),
+		},
+		{
+			name: "escape",
+			tarball: func(b *layoutBuilder) []byte {
+				return makeTar(t, []entry{{name: "../oci-layout", data: "{}"}})
+			},
+			wantErr: utils.ErrorTextContains(t, "is not relative to the image layout"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, err := NewStore(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b := &layoutBuilder{t: t, blobs: make(map[string][]byte)}
+			got, err := s.Import(bytes.NewReader(tt.tarball(b)))
+			if !tt.wantErr(err) {
+				t.Fatalf("Store.Import() error = %v", err)
+			}
+			if err != nil {
+				var invalid *ErrInvalidImage
+				if !errors.As(err, &invalid) {
+					t.Errorf("Store.Import() error = %v, want ErrInvalidImage", err)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("Store.Import() = %+v, want one image", got)
+			}
+			if tt.want != nil && got[0].Name != tt.want[0] {
+				t.Errorf("Store.Import() name = %v, want %v", got[0].Name, tt.want[0])
+			}
+			if got[0].Name == "" || got[0].WorkingDir != "/srv" || !reflect.DeepEqual(got[0].Env, []string{"PATH=/bin"}) {
+				t.Errorf("Store.Import() = %+v, want the config of the image", got[0])
+			}
+
+			for _, name := range []string{got[0].Name, got[0].Digest} {
+				if img, err := s.Get(name); err != nil || img.Digest != got[0].Digest {
+					t.Errorf("Store.Get(%v) = %+v, %v", name, img, err)
+				}
+			}
+			if list, err := s.List(); err != nil || len(list) != 1 {
+				t.Errorf("Store.List() = %+v, %v", list, err)
+			}
+		})
+	}
+}
+
+func TestStore_LayerDirs(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := makeTar(t, []entry{
+		{name: "bin/"},
+		{name: "bin/app", data: "app"},
+		{name: "etc/"},
+		{name: "etc/passwd", data: "root"},
+		{name: "etc/hosts", linkname: "etc/passwd", typeflag: tar.TypeLink},
+		{name: "lib", linkname: "/usr/lib", typeflag: tar.TypeSymlink},
+	})
+	top := makeTar(t, []entry{
+		{name: "etc/.wh.passwd"},
+		{name: "bin/.wh..wh..opq"},
+		{name: "bin/sh", data: "sh"},
+		{name: "../../escape", data: "x"},
+	})
+	b := &layoutBuilder{t: t, blobs: make(map[string][]byte)}
+	images, err := s.Import(bytes.NewReader(b.tarball(b.image(runtime.GOARCH, base, top))))
+	if err != nil {
+		t.Skipf("cannot import layers with whiteouts: %v", err)
+	}
+
+	dirs, err := s.LayerDirs(images[0])
+	if err != nil || len(dirs) != 2 {
+		t.Fatalf("Store.LayerDirs() = %v, %v", dirs, err)
+	}
+	upper, lower := dirs[0], dirs[1]
+
+	if data, err := os.ReadFile(filepath.Join(lower, "etc", "hosts")); err != nil || string(data) != "root" {
+		t.Errorf("hard link = %q, %v", data, err)
+	}
+	if target, err := os.Readlink(filepath.Join(lower, "lib")); err != nil || target != "/usr/lib" {
+		t.Errorf("symlink = %q, %v", target, err)
+	}
+	var st unix.Stat_t
+	if err := unix.Lstat(filepath.Join(upper, "etc", "passwd"), &st); err != nil || st.Mode&unix.S_IFMT != unix.S_IFCHR || st.Rdev != 0 {
+		t.Errorf("whiteout = %+v, %v, want a 0/0 character device", st, err)
+	}
+	buf := make([]byte, 1)
+	if n, err := unix.Lgetxattr(filepath.Join(upper, "bin"), opaqueXattr, buf); err != nil || string(buf[:n]) != "y" {
+		t.Errorf("opaque xattr = %q, %v", buf[:n], err)
+	}
+	if _, err := os.Stat(filepath.Join(upper, "escape")); err != nil {
+		t.Errorf("escaping entry = %v, want it kept in the layer", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escape")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("escaping entry was written outside of the layer")
+	}
+
+	// layers are shared by digest, so they are not unpacked again
+	if err := os.WriteFile(filepath.Join(lower, "marker"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Import(bytes.NewReader(b.tarball(named(b.image(runtime.GOARCH, base), "other")))); err != nil {
+		t.Fatalf("Store.Import() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(lower, "marker")); err != nil {
+		t.Errorf("Store.Import() unpacked a stored layer again")
+	}
+}
+
+func TestImage_Command(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		img      Image
+		args     []string
+		wantCmd  string
+		wantArgs []string
+		wantOK   bool
+	}{
+		{"defaults", Image{Entrypoint: []string{"/bin/app"}, Cmd: []string{"--serve"}}, nil, "/bin/app", []string{"--serve"}, true},
+		{"args replace cmd", Image{Entrypoint: []string{"/bin/app"}, Cmd: []string{"--serve"}}, []string{"--once"}, "/bin/app", []string{"--once"}, true},
+		{"cmd only", Image{Cmd: []string{"/bin/sh", "-c", "true"}}, nil, "/bin/sh", []string{"-c", "true"}, true},
+		{"args only", Image{}, []string{"/bin/true"}, "/bin/true", []string{}, true},
+		{"nothing", Image{}, nil, "", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cmd, args, ok := tt.img.Command(tt.args)
+			if cmd != tt.wantCmd || !reflect.DeepEqual(args, tt.wantArgs) || ok != tt.wantOK {
+				t.Errorf("Image.Command() = %v, %v, %v, want %v, %v, %v", cmd, args, ok, tt.wantCmd, tt.wantArgs, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package image
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// Whiteouts of the OCI image layer format, which mark deleted files and opaque directories.
+// See: https://github.com/opencontainers/image-spec/blob/main/layer.md#whiteouts
+const (
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = whiteoutPrefix + whiteoutPrefix + ".opq"
+)
+
+// opaqueXattr marks a directory that hides the directories of lower layers.
+// Layers are mounted with userxattr, since trusted xattrs need privileges on the host.
+const opaqueXattr = "user.overlay.opaque"
+
+// unpackLayer unpacks the layer tarball r into the empty directory dst, converting
+// whiteouts into the overlayfs format. Entries never resolve outside of dst: a parent
+// of an entry must not be a symlink, and hard links must target an earlier entry.
+//
+// Ownership is not kept, every file is owned by the calling user, who is root in the
+// user namespace of a Job. Devices cannot be created without privileges, so they are skipped.
+func unpackLayer(dst string, r io.Reader) error {
+	type dirMode struct {
+		path string
+		mode fs.FileMode
+	}
+	// directories stay writable until every entry is unpacked
+	var dirs []dirMode
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return invalidImage(fmt.Sprintf("malformed layer: %v", err))
+		}
+
+		// rooted, so ".." cannot leave the layer
+		name := strings.TrimPrefix(path.Clean("/"+hdr.Name), "/")
+		if name == "" {
+			continue
+		}
+		dir, base := path.Split(name)
+		if err := mkdirBeneath(dst, dir); err != nil {
+			return err
+		}
+		p := filepath.Join(dst, filepath.FromSlash(name))
+
+		switch {
+		case base == whiteoutOpaque:
+			if err := unix.Lsetxattr(filepath.Dir(p), opaqueXattr, []byte("y"), 0); err != nil {
+				return fmt.Errorf("failed to mark %s opaque: %w", dir, err)
+			}
+			continue
+		case strings.HasPrefix(base, whiteoutPrefix):
+			p = filepath.Join(filepath.Dir(p), strings.TrimPrefix(base, whiteoutPrefix))
+			if err := os.RemoveAll(p); err != nil {
+				return err
+			}
+			// a character device with device number 0/0
+			if err := unix.Mknod(p, unix.S_IFCHR, 0); err != nil {
+				return fmt.Errorf("failed to create whiteout for %s: %w", name, err)
+			}
+			continue
+		}
+
+		// an entry replaces an earlier one, unless both are directories
+		if fi, err := os.Lstat(p); err == nil && !(fi.IsDir() && hdr.Typeflag == tar.TypeDir) {
+			if err := os.RemoveAll(p); err != nil {
+				return err
+			}
+		}
+
+		mode := fs.FileMode(hdr.Mode) & fs.ModePerm
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.Mkdir(p, 0o755); err != nil && !errors.Is(err, fs.ErrExist) {
+				return err
+			}
+			dirs = append(dirs, dirMode{p, mode})
+			continue
+		case tar.TypeReg:
+			if err := writeFile(p, tr); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			// only followed within the root of the Job, never while unpacking
+			if err := os.Symlink(hdr.Linkname, p); err != nil {
+				return err
+			}
+			continue
+		case tar.TypeLink:
+			target := strings.TrimPrefix(path.Clean("/"+hdr.Linkname), "/")
+			if err := mkdirBeneath(dst, path.Dir(target)); err != nil {
+				return err
+			}
+			fi, err := os.Lstat(filepath.Join(dst, filepath.FromSlash(target)))
+			if err != nil || !fi.Mode().IsRegular() {
+				return invalidImage(fmt.Sprintf("hard link '%s' must target a regular file in its layer", name))
+			}
+			if err := os.Link(filepath.Join(dst, filepath.FromSlash(target)), p); err != nil {
+				return err
+			}
+			continue
+		case tar.TypeFifo:
+			if err := unix.Mkfifo(p, 0o644); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+		if err := os.Chmod(p, mode); err != nil {
+			return err
+		}
+	}
+
+	// innermost first, so a read-only parent does not hide its children
+	for _, d := range slices.Backward(dirs) {
+		if err := os.Chmod(d.path, d.mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFile creates the regular file p with the contents of r.
+func writeFile(p string, r io.Reader) error {
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_EXCL|os.O_WRONLY|unix.O_NOFOLLOW, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// mkdirBeneath creates every missing directory of dir, relative to root.
+// An error is returned if any existing parent is not a directory, such as a symlink,
+// which could resolve outside of root.
+func mkdirBeneath(root string, dir string) error {
+	p := root
+	for _, elem := range strings.Split(strings.Trim(dir, "/"), "/") {
+		if elem == "" || elem == "." {
+			continue
+		}
+		p = filepath.Join(p, elem)
+		fi, err := os.Lstat(p)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			if err := os.Mkdir(p, 0o755); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		case !fi.IsDir():
+			return invalidImage(fmt.Sprintf("'%s' is not a directory in its layer", strings.TrimPrefix(p, root+"/")))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+// Package inputs stages files and standard input on the host before a [work.Job]
+// is started. Staged inputs are claimed exactly once, by the Job that uses them.
+//
+// All staged inputs are managed by the [Store].
+package inputs
@@ -0,0 +1,38 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTooLarge is returned if an upload exceeds the size limit of the [Store].
+var ErrTooLarge = errors.New("inputs exceed the size limit")
+
+func invalidPath(path string, reason string) *ErrInvalidPath {
+	return &ErrInvalidPath{path, reason}
+}
+
+// ErrInvalidPath is returned if an uploaded file path cannot be staged.
+type ErrInvalidPath struct {
+	path   string
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidPath) Error() string {
+	return fmt.Sprintf("invalid path '%s': %s", e.path, e.reason)
+}
+
+func inputsNotFound(name string) *ErrNotFound {
+	return &ErrNotFound{name}
+}
+
+// ErrNotFound is returned if no unclaimed inputs are found for a given name.
+type ErrNotFound struct {
+	name string
+}
+
+// Error implements error.
+func (e *ErrNotFound) Error() string {
+	return fmt.Sprintf("no inputs found with name='%v'", e.name)
+}
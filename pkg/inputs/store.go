@@ -0,0 +1,284 @@
+package inputs
+
+import (
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/work"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultMaxBytes is the size limit of each upload if unset.
+	DefaultMaxBytes int64 = 64 << 20
+	// TTL is how long staged inputs are kept before they must be claimed.
+	TTL = time.Hour
+)
+
+const (
+	// filesDir holds the uploaded files of staged inputs.
+	filesDir = "files"
+	// stdinFile holds the uploaded standard input of staged inputs.
+	stdinFile = "stdin"
+)
+
+// Staged describes inputs that were uploaded, but not yet claimed by a Job.
+type Staged struct {
+	Name string
+	// Owner that uploaded the inputs.
+	Owner string
+	// Files lists the relative path of every uploaded file, in upload order.
+	Files []string
+	// Size is the total number of bytes uploaded, including standard input.
+	Size int64
+	// Stdin is true if standard input was uploaded.
+	Stdin bool
+	// ExpireTime is when the inputs are removed if not claimed.
+	ExpireTime time.Time
+
+	dir string
+}
+
+// clone returns a deep copy of s that is safe to hand out.
+func (s Staged) clone() Staged {
+	s.Files = slices.Clone(s.Files)
+	return s
+}
+
+// LogValue implements slog.LogValuer.
+func (s Staged) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("owner", s.Owner),
+		slog.String("name", s.Name),
+		slog.Int("files", len(s.Files)),
+		slog.Int64("size", s.Size),
+		slog.Bool("stdin", s.Stdin),
+	)
+}
+
+// Store is a thread-safe manager of [Staged] inputs on the host filesystem.
+// Inputs that are not claimed within the [TTL] are removed.
+//
+// See [NewStore].
+type Store struct {
+	mu       sync.Mutex
+	dir      string
+	maxBytes int64
+	staged   map[string]Staged
+}
+
+// NewStore creates an initialized [Store] that stages inputs under dir.
+// Each upload is limited to maxBytes, or [DefaultMaxBytes] if zero.
+//
+// dir must be on the same filesystem as the work root of the [work.Executor],
+// so claimed inputs can be moved into place.
+func NewStore(dir string, maxBytes int64) *Store {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+
+	return &Store{
+		mu:       sync.Mutex{},
+		dir:      dir,
+		maxBytes: maxBytes,
+		staged:   make(map[string]Staged),
+	}
+}
+
+// Begin starts a new [Upload] owned by owner.
+// Any expired inputs are removed.
+func (s *Store) Begin(owner string) (*Upload, error) {
+	s.prune()
+
+	uid := uuid.New().String()
+	dir := filepath.Join(s.dir, uid)
+	if err := os.MkdirAll(filepath.Join(dir, filesDir), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+
+	return &Upload{
+		store: s,
+		staged: Staged{
+			Name:  path.Join(owner, "/jobInputs/", uid),
+			Owner: owner,
+			dir:   dir,
+		},
+	}, nil
+}
+
+// Claim removes the [Staged] inputs from the Store, so they can be used by a single Job.
+// The caller takes ownership of the returned [work.Inputs].
+//
+// [ErrNotFound] is returned if no unclaimed inputs exist with the given name and owner.
+func (s *Store) Claim(owner string, name string) (Staged, work.Inputs, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.staged[name]
+	if !ok || st.Owner != owner || time.Now().After(st.ExpireTime) {
+		return Staged{}, work.Inputs{}, inputsNotFound(name)
+	}
+	delete(s.staged, name)
+
+	in := work.Inputs{Dir: filepath.Join(st.dir, filesDir)}
+	if st.Stdin {
+		in.Stdin = filepath.Join(st.dir, stdinFile)
+	}
+	return st.clone(), in, nil
+}
+
+// prune removes all expired inputs.
+func (s *Store) prune() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for name, st := range s.staged {
+		if now.After(st.ExpireTime) {
+			delete(s.staged, name)
+			_ = os.RemoveAll(st.dir)
+			slog.Info("Inputs expired", slog.Any("inputs", st.LogValue()))
+		}
+	}
+}
+
+// commit makes the uploaded inputs available to [Store.Claim].
+func (s *Store) commit(st Staged) Staged {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st.ExpireTime = time.Now().Add(TTL)
+	s.staged[st.Name] = st
+
+	slog.Info("Inputs staged", slog.Any("inputs", st.LogValue()))
+	return st.clone()
+}
+
+// Upload writes files and standard input for a single set of [Staged] inputs.
+// Files are written in order, one after another.
+//
+// This type is NOT thread-safe.
+type Upload struct {
+	store  *Store
+	staged Staged
+	file   *os.File
+	stdin  *os.File
+}
+
+// Write appends data to the file at the relative path p. A new file is created
+// with the given permission bits if p differs from the previous call, once cleaned.
+//
+// [ErrInvalidPath] is returned if p is not local, or was already written.
+// [ErrTooLarge] is returned if the size limit is exceeded.
+func (u *Upload) Write(p string, mode fs.FileMode, data []byte) error {
+	if n := len(u.staged.Files); n == 0 || u.staged.Files[n-1] != filepath.Clean(p) {
+		if err := u.create(p, mode); err != nil {
+			return err
+		}
+	}
+
+	if err := u.grow(len(data)); err != nil {
+		return err
+	}
+	_, err := u.file.Write(data)
+	return err
+}
+
+// WriteStdin appends data to the standard input of the Job.
+//
+// [ErrTooLarge] is returned if the size limit is exceeded.
+func (u *Upload) WriteStdin(data []byte) error {
+	if u.stdin == nil {
+		f, err := os.OpenFile(filepath.Join(u.staged.dir, stdinFile), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to create stdin: %w", err)
+		}
+		u.stdin = f
+		u.staged.Stdin = true
+	}
+
+	if err := u.grow(len(data)); err != nil {
+		return err
+	}
+	_, err := u.stdin.Write(data)
+	return err
+}
+
+// Commit finishes the Upload and stages the inputs until they are claimed.
+func (u *Upload) Commit() (Staged, error) {
+	if err := u.close(); err != nil {
+		u.Abort()
+		return Staged{}, err
+	}
+	return u.store.commit(u.staged), nil
+}
+
+// Abort discards everything written by the Upload.
+func (u *Upload) Abort() {
+	_ = u.close()
+	_ = os.RemoveAll(u.staged.dir)
+}
+
+// create closes the current file, then creates a new file at the relative path p.
+func (u *Upload) create(p string, mode fs.FileMode) error {
+	clean := filepath.Clean(p)
+	if !filepath.IsLocal(clean) || clean == "." {
+		return invalidPath(p, "must be a relative path within the working directory")
+	}
+	if slices.Contains(u.staged.Files, clean) {
+		return invalidPath(p, "was already uploaded")
+	}
+	if mode == 0 {
+		mode = 0o644
+	}
+
+	if u.file != nil {
+		if err := u.file.Close(); err != nil {
+			return err
+		}
+		u.file = nil
+	}
+
+	full := filepath.Join(u.staged.dir, filesDir, clean)
+	if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for '%s': %w", p, err)
+	}
+	f, err := os.OpenFile(full, os.O_CREATE|os.O_WRONLY|os.O_EXCL, mode.Perm())
+	if err != nil {
+		return invalidPath(p, err.Error())
+	}
+
+	u.file = f
+	u.staged.Files = append(u.staged.Files, clean)
+	return nil
+}
+
+// grow adds n bytes to the upload size, checking the size limit.
+func (u *Upload) grow(n int) error {
+	u.staged.Size += int64(n)
+	if u.staged.Size > u.store.maxBytes {
+		return ErrTooLarge
+	}
+	return nil
+}
+
+// close closes any open files.
+func (u *Upload) close() error {
+	var err error
+	for _, f := range []*os.File{u.file, u.stdin} {
+		if f != nil {
+			if e := f.Close(); e != nil && err == nil {
+				err = e
+			}
+		}
+	}
+	u.file, u.stdin = nil, nil
+	return err
+}
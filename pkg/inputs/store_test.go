@@ -0,0 +1,165 @@
+package inputs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestUpload_Write(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		path    string
+		wantErr func(error) bool
+	}{
+		{name: "file", path: "script.sh", wantErr: utils.NoError(t)},
+		{name: "nested", path: "lib/util.sh", wantErr: utils.NoError(t)},
+		{name: "cleaned", path: "lib/../main.sh", wantErr: utils.NoError(t)},
+		{name: "absolute", path: "/etc/passwd", wantErr: utils.ErrorTextContains(t, "must be a relative path")},
+		{name: "parent", path: "../escape", wantErr: utils.ErrorTextContains(t, "must be a relative path")},
+		{name: "empty", path: "", wantErr: utils.ErrorTextContains(t, "must be a relative path")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStore(t.TempDir(), 0)
+			u, err := s.Begin("users/test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer u.Abort()
+
+			if err := u.Write(tt.path, 0o755, []byte("echo hi")); !tt.wantErr(err) {
+				t.Errorf("Upload.Write() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestUpload_WriteContinued(t *testing.T) {
+	t.Parallel()
+	s := NewStore(t.TempDir(), 0)
+	u, err := s.Begin("users/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer u.Abort()
+
+	// the same file, however its path is spelled, is continued
+	for _, p := range []string{"lib//util.sh", "./lib/util.sh", "lib/util.sh"} {
+		if err := u.Write(p, 0o755, []byte("echo hi\n")); err != nil {
+			t.Fatalf("Upload.Write(%q) error = %v", p, err)
+		}
+	}
+
+	got, err := os.ReadFile(filepath.Join(u.staged.dir, filesDir, "lib/util.sh"))
+	if err != nil || string(got) != "echo hi\necho hi\necho hi\n" {
+		t.Errorf("Upload.Write() wrote %q, error = %v, want three lines", got, err)
+	}
+	if !slices.Equal(u.staged.Files, []string{"lib/util.sh"}) {
+		t.Errorf("Upload.Write() files = %v, want [lib/util.sh]", u.staged.Files)
+	}
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+	s := NewStore(t.TempDir(), 16)
+
+	u, err := s.Begin("users/test")
+	if err != nil {
+		t.Fatalf("Store.Begin() error = %v", err)
+	}
+	for _, chunk := range []string{"echo ", "hi"} {
+		if err := u.Write("script.sh", 0o755, []byte(chunk)); err != nil {
+			t.Fatalf("Upload.Write() error = %v", err)
+		}
+	}
+	if err := u.WriteStdin([]byte("input")); err != nil {
+		t.Fatalf("Upload.WriteStdin() error = %v", err)
+	}
+	if err := u.Write("other.sh", 0, nil); err != nil {
+		t.Fatalf("Upload.Write() error = %v", err)
+	}
+	if err := u.Write("script.sh", 0, nil); err == nil {
+		t.Errorf("Upload.Write() should reject a file that was already uploaded")
+	}
+
+	st, err := u.Commit()
+	if err != nil {
+		t.Fatalf("Upload.Commit() error = %v", err)
+	}
+	if !slices.Equal(st.Files, []string{"script.sh", "other.sh"}) || st.Size != 12 || !st.Stdin {
+		t.Errorf("Upload.Commit() = %+v", st)
+	}
+
+	if _, _, err := s.Claim("users/other", st.Name); err == nil {
+		t.Errorf("Store.Claim() should not return inputs of another owner")
+	}
+	_, in, err := s.Claim("users/test", st.Name)
+	if err != nil {
+		t.Fatalf("Store.Claim() error = %v", err)
+	}
+	if data, err := os.ReadFile(filepath.Join(in.Dir, "script.sh")); err != nil || string(data) != "echo hi" {
+		t.Errorf("Store.Claim() script.sh = %q, %v", data, err)
+	}
+	if fi, err := os.Stat(filepath.Join(in.Dir, "script.sh")); err != nil || fi.Mode().Perm() != 0o755 {
+		t.Errorf("Store.Claim() script.sh mode = %v, %v", fi.Mode(), err)
+	}
+	if data, err := os.ReadFile(in.Stdin); err != nil || string(data) != "input" {
+		t.Errorf("Store.Claim() stdin = %q, %v", data, err)
+	}
+
+	var notFound *ErrNotFound
+	if _, _, err := s.Claim("users/test", st.Name); !errors.As(err, &notFound) {
+		t.Errorf("Store.Claim() error = %v, inputs may only be claimed once", err)
+	}
+
+	// size limit
+	u, err = s.Begin("users/test")
+	if err != nil {
+		t.Fatalf("Store.Begin() error = %v", err)
+	}
+	if err := u.Write("big", 0, make([]byte, 17)); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Upload.Write() error = %v, want %v", err, ErrTooLarge)
+	}
+	u.Abort()
+	if _, err := os.Stat(u.staged.dir); !os.IsNotExist(err) {
+		t.Errorf("Upload.Abort() did not remove the staging directory, stat error = %v", err)
+	}
+}
+
+func TestStore_prune(t *testing.T) {
+	t.Parallel()
+	s := NewStore(t.TempDir(), 0)
+
+	u, err := s.Begin("users/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := u.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// expire the inputs
+	s.mu.Lock()
+	expired := s.staged[st.Name]
+	expired.ExpireTime = time.Now().Add(-time.Second)
+	s.staged[st.Name] = expired
+	s.mu.Unlock()
+
+	if _, err := s.Begin("users/test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.Claim("users/test", st.Name); err == nil {
+		t.Errorf("Store.Claim() should not return expired inputs")
+	}
+	if _, err := os.Stat(expired.dir); !os.IsNotExist(err) {
+		t.Errorf("Store.Begin() did not remove expired inputs, stat error = %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+// Package labels validates [Job] metadata and matches it using selectors.
+//
+// Keys and values follow the Kubernetes rules for labels and annotations,
+// and selectors follow the Kubernetes label selector syntax, for example:
+//
+//	team=infra,ticket!=OPS-1,env in (dev,staging),!canary
+//
+// [Job]: https://pkg.go.dev/github.com/drrev/telehandler/pkg/work#Job
+package labels
@@ -0,0 +1,25 @@
+package labels
+
+import "fmt"
+
+func invalidKey(key string, reason string) *ErrInvalid {
+	return &ErrInvalid{fmt.Sprintf("key '%s' is invalid: %s", key, reason)}
+}
+
+func invalidValue(value string) *ErrInvalid {
+	return &ErrInvalid{fmt.Sprintf("value '%s' is invalid: must be at most 63 alphanumeric characters, '-', '_', or '.', starting and ending with an alphanumeric character", value)}
+}
+
+func invalidSelector(selector string, reason string) *ErrInvalid {
+	return &ErrInvalid{fmt.Sprintf("selector '%s' is invalid: %s", selector, reason)}
+}
+
+// ErrInvalid is returned if a key, value, or selector is malformed.
+type ErrInvalid struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalid) Error() string {
+	return e.reason
+}
@@ -0,0 +1,105 @@
+package labels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestValidateKey(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		key     string
+		wantErr func(error) bool
+	}{
+		{key: "team", wantErr: utils.NoError(t)},
+		{key: "app.kubernetes.io/name", wantErr: utils.NoError(t)},
+		{key: "Ticket_ID.v2", wantErr: utils.NoError(t)},
+		{key: "", wantErr: utils.ErrorTextContains(t, "name must be")},
+		{key: "-team", wantErr: utils.ErrorTextContains(t, "name must be")},
+		{key: strings.Repeat("a", 64), wantErr: utils.ErrorTextContains(t, "name must be")},
+		{key: "/team", wantErr: utils.ErrorTextContains(t, "prefix must be")},
+		{key: "Example.com/team", wantErr: utils.ErrorTextContains(t, "prefix must be")},
+		{key: "a/b/c", wantErr: utils.ErrorTextContains(t, "name must be")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if err := ValidateKey(tt.key); !tt.wantErr(err) {
+				t.Errorf("ValidateKey() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value   string
+		wantErr func(error) bool
+	}{
+		{value: "", wantErr: utils.NoError(t)},
+		{value: "OPS-123", wantErr: utils.NoError(t)},
+		{value: "has space", wantErr: utils.ErrorTextContains(t, "is invalid")},
+		{value: "trailing-", wantErr: utils.ErrorTextContains(t, "is invalid")},
+		{value: strings.Repeat("a", 64), wantErr: utils.ErrorTextContains(t, "is invalid")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if err := ValidateValue(tt.value); !tt.wantErr(err) {
+				t.Errorf("ValidateValue() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	t.Parallel()
+	if err := ValidateAnnotations(map[string]string{"description": "free form text, with spaces"}); err != nil {
+		t.Errorf("ValidateAnnotations() error = %v", err)
+	}
+	if err := ValidateAnnotations(map[string]string{"big": strings.Repeat("a", MaxAnnotationsSize)}); !utils.ErrorTextContains(t, "must be at most")(err) {
+		t.Errorf("ValidateAnnotations() error = %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	labels := map[string]string{"team": "infra", "env": "dev", "ticket": "OPS-1"}
+
+	tests := []struct {
+		selector string
+		want     bool
+		wantErr  func(error) bool
+	}{
+		{selector: "", want: true, wantErr: utils.NoError(t)},
+		{selector: "team", want: true, wantErr: utils.NoError(t)},
+		{selector: "!team", want: false, wantErr: utils.NoError(t)},
+		{selector: "!canary", want: true, wantErr: utils.NoError(t)},
+		{selector: "team=infra", want: true, wantErr: utils.NoError(t)},
+		{selector: "team==infra", want: true, wantErr: utils.NoError(t)},
+		{selector: "team!=infra", want: false, wantErr: utils.NoError(t)},
+		{selector: "owner!=alice", want: true, wantErr: utils.NoError(t)},
+		{selector: "env in (dev, staging)", want: true, wantErr: utils.NoError(t)},
+		{selector: "env notin (dev,staging)", want: false, wantErr: utils.NoError(t)},
+		{selector: "team=infra, env in (dev,staging), !canary", want: true, wantErr: utils.NoError(t)},
+		{selector: "team=infra,ticket=OPS-2", want: false, wantErr: utils.NoError(t)},
+		{selector: "team=,", wantErr: utils.ErrorTextContains(t, "empty requirement")},
+		{selector: "team=in fra", wantErr: utils.ErrorTextContains(t, "is invalid")},
+		{selector: "-team", wantErr: utils.ErrorTextContains(t, "is invalid")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			sel, err := Parse(tt.selector)
+			if !tt.wantErr(err) {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if got := sel.Matches(labels); got != tt.want {
+				t.Errorf("Selector.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package labels
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Operator compares the value of a label with the values of a [Requirement].
+type Operator string
+
+const (
+	// The label must be set, with any value.
+	Exists Operator = "exists"
+	// The label must not be set.
+	DoesNotExist Operator = "!"
+	// The label must be set to the value.
+	Equals Operator = "="
+	// The label must not be set, or set to a different value.
+	NotEquals Operator = "!="
+	// The label must be set to one of the values.
+	In Operator = "in"
+	// The label must not be set, or set to none of the values.
+	NotIn Operator = "notin"
+)
+
+// Requirement is a single condition on the labels of a Job.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Matches checks if the requirement is satisfied by labels.
+func (r Requirement) Matches(labels map[string]string) bool {
+	v, ok := labels[r.Key]
+	switch r.Operator {
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	case Equals, In:
+		return ok && slices.Contains(r.Values, v)
+	case NotEquals, NotIn:
+		return !ok || !slices.Contains(r.Values, v)
+	}
+	return false
+}
+
+// Selector matches labels if all requirements are satisfied.
+// An empty Selector matches everything.
+type Selector []Requirement
+
+// Matches checks if all requirements are satisfied by labels.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+var setRe = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// Parse a comma-separated list of requirements into a [Selector].
+// An empty string selects everything.
+//
+// Supported requirements are: key, !key, key=value, key==value,
+// key!=value, key in (v1,v2), and key notin (v1,v2).
+//
+// [ErrInvalid] is returned if the selector is malformed.
+func Parse(selector string) (Selector, error) {
+	var sel Selector
+	if strings.TrimSpace(selector) == "" {
+		return sel, nil
+	}
+
+	for _, raw := range split(selector) {
+		r, err := parseRequirement(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, invalidSelector(selector, err.Error())
+		}
+		sel = append(sel, r)
+	}
+	return sel, nil
+}
+
+// split a selector on all commas outside of parentheses.
+func split(selector string) (parts []string) {
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selector[start:])
+}
+
+func parseRequirement(raw string) (r Requirement, err error) {
+	switch {
+	case raw == "":
+		return r, &ErrInvalid{"empty requirement"}
+
+	case strings.HasPrefix(raw, "!") && !strings.Contains(raw, "="):
+		r = Requirement{Key: strings.TrimSpace(raw[1:]), Operator: DoesNotExist}
+
+	case setRe.MatchString(raw):
+		m := setRe.FindStringSubmatch(raw)
+		r = Requirement{Key: m[1], Operator: Operator(m[2])}
+		for _, v := range strings.Split(m[3], ",") {
+			r.Values = append(r.Values, strings.TrimSpace(v))
+		}
+
+	case strings.Contains(raw, "!="):
+		k, v, _ := strings.Cut(raw, "!=")
+		r = Requirement{Key: strings.TrimSpace(k), Operator: NotEquals, Values: []string{strings.TrimSpace(v)}}
+
+	case strings.Contains(raw, "="):
+		k, v, _ := strings.Cut(raw, "=")
+		v = strings.TrimPrefix(v, "=")
+		r = Requirement{Key: strings.TrimSpace(k), Operator: Equals, Values: []string{strings.TrimSpace(v)}}
+
+	default:
+		r = Requirement{Key: raw, Operator: Exists}
+	}
+
+	if err := ValidateKey(r.Key); err != nil {
+		return r, err
+	}
+	for _, v := range r.Values {
+		if err := ValidateValue(v); err != nil {
+			return r, err
+		}
+	}
+	return r, nil
+}
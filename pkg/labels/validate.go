@@ -0,0 +1,82 @@
+package labels
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MaxNameLength is the maximum length of a key name or label value.
+	MaxNameLength = 63
+	// MaxPrefixLength is the maximum length of a key prefix.
+	MaxPrefixLength = 253
+	// MaxAnnotationsSize is the maximum total size of all annotation keys and values.
+	MaxAnnotationsSize = 256 << 10
+)
+
+var (
+	nameRe   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	prefixRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// ValidateKey checks a label or annotation key. A key is a name with an
+// optional DNS subdomain prefix, separated by a slash, such as example.com/team.
+func ValidateKey(key string) error {
+	prefix, name, found := strings.Cut(key, "/")
+	if !found {
+		name, prefix = prefix, ""
+	}
+
+	if found {
+		if len(prefix) == 0 || len(prefix) > MaxPrefixLength || !prefixRe.MatchString(prefix) {
+			return invalidKey(key, "prefix must be a lowercase DNS subdomain of at most 253 characters")
+		}
+	}
+
+	if len(name) == 0 || len(name) > MaxNameLength || !nameRe.MatchString(name) {
+		return invalidKey(key, "name must be at most 63 alphanumeric characters, '-', '_', or '.', starting and ending with an alphanumeric character")
+	}
+
+	return nil
+}
+
+// ValidateValue checks a label value. Values may be empty.
+func ValidateValue(value string) error {
+	if len(value) == 0 {
+		return nil
+	}
+	if len(value) > MaxNameLength || !nameRe.MatchString(value) {
+		return invalidValue(value)
+	}
+	return nil
+}
+
+// ValidateLabels checks all keys and values of labels.
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if err := ValidateKey(k); err != nil {
+			return err
+		}
+		if err := ValidateValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateAnnotations checks all keys of annotations. Values are not
+// restricted, but the total size must not exceed [MaxAnnotationsSize].
+func ValidateAnnotations(annotations map[string]string) error {
+	size := 0
+	for k, v := range annotations {
+		if err := ValidateKey(k); err != nil {
+			return err
+		}
+		size += len(k) + len(v)
+	}
+	if size > MaxAnnotationsSize {
+		return &ErrInvalid{fmt.Sprintf("annotations total %d bytes, must be at most %d bytes", size, MaxAnnotationsSize)}
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+// Package landlock restricts the host paths a process can access with Landlock.
+//
+// A [Ruleset] lists paths that are read-only or read-write. Once restricted, every
+// other path is inaccessible, except through file descriptors opened beforehand.
+// Rights the kernel does not support are not restricted, so rulesets work on any
+// kernel with Landlock, but older kernels enforce less. See [ABI].
+package landlock
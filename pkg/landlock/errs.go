@@ -0,0 +1,17 @@
+package landlock
+
+import "fmt"
+
+func unsupported(reason string) *ErrUnsupported {
+	return &ErrUnsupported{reason}
+}
+
+// ErrUnsupported is returned if the kernel cannot enforce Landlock.
+type ErrUnsupported struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrUnsupported) Error() string {
+	return fmt.Sprintf("Landlock is not supported: %s", e.reason)
+}
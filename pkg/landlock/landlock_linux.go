@@ -0,0 +1,157 @@
+package landlock
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Rights to access files, by the ABI version that introduced them.
+// See: https://docs.kernel.org/userspace-api/landlock.html#filesystem-flags
+const (
+	accessV1 = unix.LANDLOCK_ACCESS_FS_EXECUTE |
+		unix.LANDLOCK_ACCESS_FS_WRITE_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_DIR |
+		unix.LANDLOCK_ACCESS_FS_REMOVE_DIR |
+		unix.LANDLOCK_ACCESS_FS_REMOVE_FILE |
+		unix.LANDLOCK_ACCESS_FS_MAKE_CHAR |
+		unix.LANDLOCK_ACCESS_FS_MAKE_DIR |
+		unix.LANDLOCK_ACCESS_FS_MAKE_REG |
+		unix.LANDLOCK_ACCESS_FS_MAKE_SOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_FIFO |
+		unix.LANDLOCK_ACCESS_FS_MAKE_BLOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_SYM
+	accessV2 = accessV1 | unix.LANDLOCK_ACCESS_FS_REFER
+	accessV3 = accessV2 | unix.LANDLOCK_ACCESS_FS_TRUNCATE
+	accessV5 = accessV3 | unix.LANDLOCK_ACCESS_FS_IOCTL_DEV
+
+	// accessRead is granted to read-only paths.
+	accessRead = unix.LANDLOCK_ACCESS_FS_EXECUTE |
+		unix.LANDLOCK_ACCESS_FS_READ_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_DIR
+	// accessFile are the only rights that apply to a path that is not a directory.
+	accessFile = unix.LANDLOCK_ACCESS_FS_EXECUTE |
+		unix.LANDLOCK_ACCESS_FS_WRITE_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_FILE |
+		unix.LANDLOCK_ACCESS_FS_TRUNCATE |
+		unix.LANDLOCK_ACCESS_FS_IOCTL_DEV
+)
+
+// Ruleset lists the host paths a process can access. Each path grants access
+// to itself and, if it is a directory, everything beneath it.
+type Ruleset struct {
+	// ReadOnly paths can be read and executed.
+	ReadOnly []string
+	// ReadWrite paths can be read, executed, written, created, and removed.
+	ReadWrite []string
+}
+
+// Empty returns true if rs does not list any path, so nothing is restricted.
+func (rs Ruleset) Empty() bool {
+	return len(rs.ReadOnly) == 0 && len(rs.ReadWrite) == 0
+}
+
+// Validate checks that every path of rs is absolute and clean.
+func (rs Ruleset) Validate() error {
+	for _, paths := range [][]string{rs.ReadOnly, rs.ReadWrite} {
+		for _, p := range paths {
+			if !filepath.IsAbs(p) || filepath.Clean(p) != p {
+				return fmt.Errorf("path '%s' must be absolute and clean", p)
+			}
+		}
+	}
+	return nil
+}
+
+// ABI returns the version of the Landlock ABI supported by the kernel.
+// [ErrUnsupported] is returned if the kernel was built without Landlock, or it is disabled.
+func ABI() (int, error) {
+	v, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, 0, 0, unix.LANDLOCK_CREATE_RULESET_VERSION)
+	switch {
+	case errors.Is(errno, unix.ENOSYS):
+		return 0, unsupported("the kernel was built without Landlock")
+	case errors.Is(errno, unix.EOPNOTSUPP):
+		return 0, unsupported("Landlock is disabled, add it to the lsm= kernel parameter")
+	case errno != 0:
+		return 0, unsupported(errno.Error())
+	}
+	return int(v), nil
+}
+
+// handledAccess returns every right to access files supported by abi.
+func handledAccess(abi int) uint64 {
+	switch {
+	case abi >= 5:
+		return accessV5
+	case abi >= 3:
+		return accessV3
+	case abi >= 2:
+		return accessV2
+	}
+	return accessV1
+}
+
+// Restrict applies rs to the calling thread, and is inherited by every thread
+// and process it starts afterwards. A restriction cannot be removed.
+//
+// The caller must lock its goroutine to the OS thread, and never unlock it,
+// so the runtime discards the thread instead of reusing it for other goroutines.
+// The caller must have CAP_SYS_ADMIN in its user namespace, or set no_new_privs.
+// Every path must exist.
+func Restrict(rs Ruleset) error {
+	abi, err := ABI()
+	if err != nil {
+		return err
+	}
+	handled := handledAccess(abi)
+
+	attr := unix.LandlockRulesetAttr{Access_fs: handled}
+	fd, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr), 0)
+	if errno != 0 {
+		return fmt.Errorf("failed to create Landlock ruleset: %w", errno)
+	}
+	defer unix.Close(int(fd))
+
+	for _, rule := range []struct {
+		paths  []string
+		access uint64
+	}{{rs.ReadOnly, accessRead}, {rs.ReadWrite, handled}} {
+		for _, path := range rule.paths {
+			if err := addRule(int(fd), path, rule.access&handled); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_LANDLOCK_RESTRICT_SELF, fd, 0, 0); errno != 0 {
+		return fmt.Errorf("failed to enforce Landlock ruleset: %w", errno)
+	}
+	return nil
+}
+
+// addRule grants access to path, and everything beneath it, in the ruleset fd.
+func addRule(fd int, path string, access uint64) error {
+	pfd, err := unix.Open(path, unix.O_PATH|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open Landlock path '%s': %w", path, err)
+	}
+	defer unix.Close(pfd)
+
+	var st unix.Stat_t
+	if err := unix.Fstat(pfd, &st); err != nil {
+		return fmt.Errorf("failed to stat Landlock path '%s': %w", path, err)
+	}
+	if st.Mode&unix.S_IFMT != unix.S_IFDIR {
+		access &= accessFile
+	}
+
+	attr := unix.LandlockPathBeneathAttr{Allowed_access: access, Parent_fd: int32(pfd)}
+	if _, _, errno := unix.Syscall6(unix.SYS_LANDLOCK_ADD_RULE, uintptr(fd), unix.LANDLOCK_RULE_PATH_BENEATH, uintptr(unsafe.Pointer(&attr)), 0, 0, 0); errno != 0 {
+		return fmt.Errorf("failed to add Landlock path '%s': %w", path, errno)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package landlock
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+	"golang.org/x/sys/unix"
+)
+
+func TestRuleset_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		rs      Ruleset
+		wantErr func(error) bool
+	}{
+		{name: "empty", wantErr: utils.NoError(t)},
+		{name: "absolute", rs: Ruleset{ReadOnly: []string{"/usr", "/etc/hosts"}, ReadWrite: []string{"/tmp"}}, wantErr: utils.NoError(t)},
+		{name: "relative", rs: Ruleset{ReadOnly: []string{"usr"}}, wantErr: utils.ErrorTextContains(t, "must be absolute")},
+		{name: "unclean", rs: Ruleset{ReadWrite: []string{"/tmp/../etc"}}, wantErr: utils.ErrorTextContains(t, "must be absolute and clean")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rs.Validate(); !tt.wantErr(err) {
+				t.Errorf("Ruleset.Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_handledAccess(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		abi  int
+		want uint64
+	}{
+		{abi: 1, want: accessV1},
+		{abi: 2, want: accessV2},
+		{abi: 4, want: accessV3},
+		{abi: 7, want: accessV5},
+	}
+	for _, tt := range tests {
+		if got := handledAccess(tt.abi); got != tt.want {
+			t.Errorf("handledAccess(%v) = %#x, want %#x", tt.abi, got, tt.want)
+		}
+	}
+}
+
+func TestRestrict(t *testing.T) {
+	t.Parallel()
+	if _, err := ABI(); err != nil {
+		t.Skip(err)
+	}
+
+	ro, rw, hidden := t.TempDir(), t.TempDir(), t.TempDir()
+	for _, dir := range []string{ro, rw, hidden} {
+		if err := os.WriteFile(filepath.Join(dir, "file"), []byte("data"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the restricted thread is discarded once the goroutine exits
+	errCh := make(chan error)
+	go func() {
+		runtime.LockOSThread()
+
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			errCh <- err
+			return
+		}
+		if err := Restrict(Ruleset{ReadOnly: []string{ro}, ReadWrite: []string{rw}}); err != nil {
+			errCh <- err
+			return
+		}
+
+		for _, check := range []struct {
+			name   string
+			err    error
+			denied bool
+		}{
+			{name: "read read-only", err: readFile(filepath.Join(ro, "file"))},
+			{name: "write read-only", err: writeFile(filepath.Join(ro, "new")), denied: true},
+			{name: "read read-write", err: readFile(filepath.Join(rw, "file"))},
+			{name: "write read-write", err: writeFile(filepath.Join(rw, "new"))},
+			{name: "read hidden", err: readFile(filepath.Join(hidden, "file")), denied: true},
+		} {
+			if denied := errors.Is(check.err, fs.ErrPermission); denied != check.denied {
+				errCh <- errors.New(check.name + ": unexpected result: " + errString(check.err))
+				return
+			}
+		}
+		errCh <- nil
+	}()
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readFile opens path for reading.
+func readFile(path string) error {
+	fd, err := unix.Open(path, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return &fs.PathError{Op: "open", Path: path, Err: err}
+	}
+	return unix.Close(fd)
+}
+
+// writeFile creates path, or opens it for writing.
+func writeFile(path string) error {
+	fd, err := unix.Open(path, unix.O_WRONLY|unix.O_CREAT|unix.O_CLOEXEC, 0o600)
+	if err != nil {
+		return &fs.PathError{Op: "open", Path: path, Err: err}
+	}
+	return unix.Close(fd)
+}
+
+func errString(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	return err.Error()
+}
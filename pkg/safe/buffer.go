@@ -3,7 +3,9 @@ package safe
 import (
 	"errors"
 	"io"
+	"sort"
 	"sync"
+	"time"
 )
 
 // ErrClosedWriter is returned if [NotifyingBuffer.Write] is called
@@ -66,6 +68,35 @@ type NotifyingBuffer struct {
 	// find one of those?
 	buff   []byte
 	notify chan struct{}
+	// segments records when, and to which stream, each range of buff was written.
+	segments []Segment
+}
+
+// Stream identifies the source of data written to a [NotifyingBuffer].
+type Stream int
+
+const (
+	// StreamUnspecified is the stream of data written with [NotifyingBuffer.Write].
+	StreamUnspecified Stream = iota
+	// Stdout is the standard output of a process.
+	Stdout
+	// Stderr is the standard error of a process.
+	Stderr
+)
+
+// segmentMerge is the window in which consecutive writes to the same
+// stream are recorded as a single [Segment], to bound memory use.
+const segmentMerge = time.Millisecond
+
+// Segment describes a contiguous range of data in a [NotifyingBuffer]
+// that arrived at the same time from the same stream.
+type Segment struct {
+	// Offset of the first byte of the segment in the buffer.
+	Offset int
+	// Time the data arrived.
+	Time time.Time
+	// Stream the data was written to.
+	Stream Stream
 }
 
 // NewNotifyingBuffer creates a [NotifyingBuffer] that is initialized
@@ -89,20 +120,66 @@ func (b *NotifyingBuffer) Reader() *NotifyingBufferReader {
 }
 
 // Write implements io.Writer.
+// The data is recorded as [StreamUnspecified], see [NotifyingBuffer.Writer].
 func (b *NotifyingBuffer) Write(p []byte) (n int, err error) {
+	return b.write(StreamUnspecified, p)
+}
+
+// Writer returns an [io.Writer] that writes into this buffer,
+// recording the arrival time of each write as part of the given stream.
+func (b *NotifyingBuffer) Writer(stream Stream) io.Writer {
+	return streamWriter{nb: b, stream: stream}
+}
+
+// write appends p to the buffer as part of stream.
+func (b *NotifyingBuffer) write(stream Stream, p []byte) (n int, err error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	if b.closed {
 		return 0, ErrClosedWriter
 	}
+	if len(p) == 0 {
+		return 0, nil
+	}
 
+	now := time.Now()
+	if n := len(b.segments); n == 0 || b.segments[n-1].Stream != stream || now.Sub(b.segments[n-1].Time) >= segmentMerge {
+		b.segments = append(b.segments, Segment{Offset: len(b.buff), Time: now, Stream: stream})
+	}
 	b.buff = append(b.buff, p...)
 	b.broadcast()
 
 	return len(p), nil
 }
 
+// segmentAt returns the [Segment] holding the byte at offs,
+// and the offset following the last byte of that segment.
+// This method is NOT thread-safe.
+func (b *NotifyingBuffer) segmentAt(offs int) (Segment, int) {
+	i := sort.Search(len(b.segments), func(i int) bool { return b.segments[i].Offset > offs }) - 1
+	if i < 0 {
+		return Segment{}, len(b.buff)
+	}
+
+	end := len(b.buff)
+	if i+1 < len(b.segments) {
+		end = b.segments[i+1].Offset
+	}
+	return b.segments[i], end
+}
+
+// streamWriter writes into a [NotifyingBuffer] as part of a single [Stream].
+type streamWriter struct {
+	nb     *NotifyingBuffer
+	stream Stream
+}
+
+// Write implements io.Writer.
+func (w streamWriter) Write(p []byte) (int, error) {
+	return w.nb.write(w.stream, p)
+}
+
 // Close implements io.Closer.
 func (b *NotifyingBuffer) Close() error {
 	b.mu.Lock()
@@ -159,26 +236,53 @@ type NotifyingBufferReader struct {
 
 // Read implements io.Reader.
 func (r *NotifyingBufferReader) Read(p []byte) (n int, err error) {
-	for blen, closed := r.nb.Status(); r.offs >= blen && !closed; blen, closed = r.nb.Status() {
-		select {
-		case <-r.close:
-			return 0, io.EOF
-		case <-r.nb.Wait():
-		}
+	if err := r.wait(); err != nil {
+		return 0, err
 	}
 
-	if blen, closed := r.nb.Status(); r.offs >= blen && closed {
-		return 0, io.EOF
+	r.nb.mu.RLock()
+	n = copy(p, r.nb.buff[r.offs:])
+	r.nb.mu.RUnlock()
+
+	r.offs += n
+	return
+}
+
+// ReadSegment is like Read, but never reads past the end of a single [Segment].
+// The returned Segment describes the data read into p; its Offset is the
+// offset of p[0] within the buffer.
+func (r *NotifyingBufferReader) ReadSegment(p []byte) (n int, seg Segment, err error) {
+	if err := r.wait(); err != nil {
+		return 0, Segment{}, err
 	}
 
 	r.nb.mu.RLock()
-	n = copy(p, r.nb.buff[r.offs:])
+	seg, end := r.nb.segmentAt(r.offs)
+	n = copy(p, r.nb.buff[r.offs:end])
 	r.nb.mu.RUnlock()
 
+	seg.Offset = r.offs
 	r.offs += n
 	return
 }
 
+// wait blocks until there is unread data in the buffer.
+// [io.EOF] is returned if the buffer or this reader is closed first.
+func (r *NotifyingBufferReader) wait() error {
+	for blen, closed := r.nb.Status(); r.offs >= blen && !closed; blen, closed = r.nb.Status() {
+		select {
+		case <-r.close:
+			return io.EOF
+		case <-r.nb.Wait():
+		}
+	}
+
+	if blen, closed := r.nb.Status(); r.offs >= blen && closed {
+		return io.EOF
+	}
+	return nil
+}
+
 // Close implements io.Closer.
 func (r *NotifyingBufferReader) Close() error {
 	r.once.Do(func() {
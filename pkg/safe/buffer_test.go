@@ -273,3 +273,48 @@ func makeRandomSlice(t interface{ Helper() }, length int) func() []byte {
 		return data
 	}
 }
+
+func TestReadSegment(t *testing.T) {
+	t.Parallel()
+	nb := NewNotifyingBuffer()
+
+	if _, err := nb.Writer(Stdout).Write([]byte("out\n")); err != nil {
+		t.Fatalf("NotifyingBuffer.Writer().Write() unexpected error: %v", err)
+	}
+	if _, err := nb.Writer(Stderr).Write([]byte("err\n")); err != nil {
+		t.Fatalf("NotifyingBuffer.Writer().Write() unexpected error: %v", err)
+	}
+	if _, err := nb.Writer(Stdout).Write([]byte("more")); err != nil {
+		t.Fatalf("NotifyingBuffer.Writer().Write() unexpected error: %v", err)
+	}
+	nb.Close()
+
+	want := []struct {
+		data   string
+		offset int
+		stream Stream
+	}{
+		{data: "ou", offset: 0, stream: Stdout},
+		{data: "t\n", offset: 2, stream: Stdout},
+		{data: "er", offset: 4, stream: Stderr},
+		{data: "r\n", offset: 6, stream: Stderr},
+		{data: "mo", offset: 8, stream: Stdout},
+		{data: "re", offset: 10, stream: Stdout},
+	}
+
+	r := nb.Reader()
+	p := make([]byte, 2)
+	for _, w := range want {
+		n, seg, err := r.ReadSegment(p)
+		if err != nil {
+			t.Fatalf("NotifyingBufferReader.ReadSegment() unexpected error: %v", err)
+		}
+		if string(p[:n]) != w.data || seg.Offset != w.offset || seg.Stream != w.stream || seg.Time.IsZero() {
+			t.Errorf("NotifyingBufferReader.ReadSegment() = %q, %+v, want %q at %d from %v", p[:n], seg, w.data, w.offset, w.stream)
+		}
+	}
+
+	if _, _, err := r.ReadSegment(p); err != io.EOF {
+		t.Errorf("NotifyingBufferReader.ReadSegment() error = %v, want EOF", err)
+	}
+}
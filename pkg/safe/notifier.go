@@ -0,0 +1,34 @@
+package safe
+
+import "sync"
+
+// Notifier is a thread-safe utility type to broadcast change
+// notifications to any number of listeners.
+//
+// The zero value is ready to use.
+type Notifier struct {
+	mu     sync.Mutex
+	notify chan struct{}
+}
+
+// Wait returns a channel that is closed on the next call to [Notifier.Broadcast].
+func (n *Notifier) Wait() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.notify == nil {
+		n.notify = make(chan struct{})
+	}
+	return n.notify
+}
+
+// Broadcast notifies all listeners waiting on [Notifier.Wait].
+func (n *Notifier) Broadcast() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.notify != nil {
+		close(n.notify)
+		n.notify = nil
+	}
+}
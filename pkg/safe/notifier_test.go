@@ -0,0 +1,39 @@
+package safe
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNotifier(t *testing.T) {
+	t.Parallel()
+	var n Notifier
+
+	// broadcast without listeners must not panic
+	n.Broadcast()
+
+	ch1 := n.Wait()
+	ch2 := n.Wait()
+
+	select {
+	case <-ch1:
+		t.Fatal("Notifier.Wait() closed before Broadcast()")
+	default:
+	}
+
+	n.Broadcast()
+
+	for _, ch := range []<-chan struct{}{ch1, ch2} {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("Notifier.Broadcast() did not notify listener")
+		}
+	}
+
+	select {
+	case <-n.Wait():
+		t.Error("Notifier.Wait() returned a closed channel after Broadcast()")
+	default:
+	}
+}
@@ -0,0 +1,121 @@
+package schedule
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/work"
+)
+
+// schedContext wraps a [Schedule] with the timer that drives it.
+type schedContext struct {
+	m sync.Mutex
+	Schedule
+	timer   *time.Timer
+	deleted bool
+}
+
+// scheduleSafe returns a copy of the [Schedule] in a thread-safe manner.
+func (sc *schedContext) scheduleSafe() Schedule {
+	sc.m.Lock()
+	defer sc.m.Unlock()
+	return sc.Schedule.clone()
+}
+
+// arm (re)starts the timer for the next run after now.
+// sc.m must be held.
+func (sc *schedContext) arm(exe Executor, now time.Time) {
+	if sc.timer != nil {
+		sc.timer.Stop()
+	}
+
+	sc.NextRunTime = sc.next(now)
+	sc.timer = time.AfterFunc(sc.NextRunTime.Sub(now), func() {
+		sc.m.Lock()
+		defer sc.m.Unlock()
+		if sc.deleted {
+			return
+		}
+		sc.fire(exe, time.Now())
+		// deleted while the Job was started
+		if sc.deleted {
+			return
+		}
+		sc.arm(exe, time.Now())
+	})
+}
+
+// fire runs the [Schedule] once, applying the concurrency policy
+// and history limits. sc.m must be held. It is released while the Job
+// is started, since starting a Job waits for its sandbox to be set up.
+func (sc *schedContext) fire(exe Executor, now time.Time) {
+	active := sc.refresh(exe)
+
+	switch {
+	case len(active) > 0 && sc.Concurrency == Forbid:
+		slog.Info("Schedule run skipped, jobs still active", slog.Any("schedule", sc.LogValue()))
+		return
+	case len(active) > 0 && sc.Concurrency == Replace:
+		for _, name := range active {
+			if err := exe.Stop(name); err != nil {
+				slog.Error("Failed to stop replaced job", slog.String("job", name), slog.Any("err", err))
+			}
+		}
+	}
+
+	sc.LastRunTime = now
+	job := *work.NewJob(sc.Owner, sc.Cmd, slices.Clone(sc.Args))
+
+	sc.m.Unlock()
+	j, err := exe.Start(job)
+	sc.m.Lock()
+	if err != nil {
+		slog.Error("Failed to start scheduled job", slog.Any("schedule", sc.LogValue()), slog.Any("err", err))
+		return
+	}
+	sc.Jobs = append(sc.Jobs, j.Name)
+
+	slog.Info("Schedule fired", slog.Any("schedule", sc.LogValue()), slog.String("job", j.Name))
+}
+
+// refresh prunes the Job history down to the configured limits and
+// returns the names of all Jobs that are still active. sc.m must be held.
+func (sc *schedContext) refresh(exe Executor) (active []string) {
+	var completed, failed, gone []string
+	for _, name := range sc.Jobs {
+		j, err := exe.Lookup(name)
+		switch {
+		case err != nil:
+			gone = append(gone, name)
+		case j.Active():
+			active = append(active, name)
+		case j.State == work.Completed:
+			completed = append(completed, name)
+		default:
+			failed = append(failed, name)
+		}
+	}
+
+	var remove []string
+	if n := len(completed) - sc.SuccessfulHistoryLimit; n > 0 {
+		remove = append(remove, completed[:n]...)
+	}
+	if n := len(failed) - sc.FailedHistoryLimit; n > 0 {
+		remove = append(remove, failed[:n]...)
+	}
+
+	for _, name := range remove {
+		if err := exe.Remove(name); err != nil {
+			slog.Error("Failed to remove scheduled job", slog.String("job", name), slog.Any("err", err))
+		}
+	}
+
+	gone = append(gone, remove...)
+	sc.Jobs = slices.DeleteFunc(sc.Jobs, func(name string) bool {
+		return slices.Contains(gone, name)
+	})
+
+	return
+}
@@ -0,0 +1,5 @@
+// Package schedule runs recurring [work.Job] executions using cron expressions.
+// Every time a [Schedule] fires, an ordinary Job is started with the Executor.
+//
+// All schedules are managed by the [Manager].
+package schedule
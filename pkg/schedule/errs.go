@@ -0,0 +1,33 @@
+package schedule
+
+import (
+	"fmt"
+)
+
+func invalidSchedule(reason string) *ErrInvalidSchedule {
+	return &ErrInvalidSchedule{reason}
+}
+
+// ErrInvalidSchedule is returned if a [Schedule] is malformed.
+type ErrInvalidSchedule struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidSchedule) Error() string {
+	return fmt.Sprintf("invalid schedule: %s", e.reason)
+}
+
+func scheduleNotFound(name string) *ErrNotFound {
+	return &ErrNotFound{name}
+}
+
+// ErrNotFound is returned if no schedule is found for a given name.
+type ErrNotFound struct {
+	name string
+}
+
+// Error implements error.
+func (e *ErrNotFound) Error() string {
+	return fmt.Sprintf("no schedule found with name='%v'", e.name)
+}
@@ -0,0 +1,181 @@
+package schedule
+
+import (
+	"cmp"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/work"
+)
+
+// Executor is the minimal interface needed to run and clean up
+// the Jobs of each [Schedule].
+type Executor interface {
+	Start(j work.Job) (work.Job, error)
+	Stop(name string) error
+	Lookup(name string) (work.Job, error)
+	Remove(name string) error
+}
+
+// Manager is a thread-safe [Schedule] manager.
+// Each Schedule is driven by a timer that fires at the next
+// time matching its cron expression.
+//
+// See [NewManager].
+type Manager struct {
+	mu     sync.RWMutex
+	exe    Executor
+	scheds map[string]*schedContext
+}
+
+// NewManager creates an initialized [Manager] ready for use.
+func NewManager(exe Executor) *Manager {
+	return &Manager{
+		mu:     sync.RWMutex{},
+		exe:    exe,
+		scheds: make(map[string]*schedContext),
+	}
+}
+
+// Create validates and arms the given [Schedule].
+//
+// [ErrInvalidSchedule] is returned if validation fails.
+func (m *Manager) Create(s Schedule) (Schedule, error) {
+	if err := s.Validate(); err != nil {
+		return s, err
+	}
+
+	s = s.clone()
+	s.Jobs = nil
+	s.CreateTime = time.Now()
+	s.LastRunTime = time.Time{}
+
+	sc := &schedContext{Schedule: s}
+
+	m.mu.Lock()
+	m.scheds[s.Name] = sc
+	m.mu.Unlock()
+
+	sc.m.Lock()
+	defer sc.m.Unlock()
+	sc.arm(m.exe, time.Now())
+
+	slog.Info("Schedule created", slog.Any("schedule", sc.LogValue()))
+
+	return sc.Schedule.clone(), nil
+}
+
+// Lookup returns a copy of any [Schedule] found. If no Schedule is found, a [ErrNotFound]
+// is returned and the Schedule value is zero.
+func (m *Manager) Lookup(name string) (Schedule, error) {
+	sc, err := m.lookupContext(name)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	return sc.scheduleSafe(), nil
+}
+
+// List returns copies of all Schedules owned by owner, oldest first.
+func (m *Manager) List(owner string) []Schedule {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var out []Schedule
+	for name, sc := range m.scheds {
+		if strings.HasPrefix(name, owner+"/") {
+			out = append(out, sc.scheduleSafe())
+		}
+	}
+
+	slices.SortFunc(out, func(a, b Schedule) int {
+		if c := a.CreateTime.Compare(b.CreateTime); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return out
+}
+
+// Update replaces the configuration of an existing [Schedule] with the
+// configuration from s. Job history and timestamps are preserved, and the
+// Schedule is re-armed using the new cron expression.
+//
+// [ErrNotFound] is returned if no Schedule exists with s.Name.
+// [ErrInvalidSchedule] is returned if validation fails.
+func (m *Manager) Update(s Schedule) (Schedule, error) {
+	sc, err := m.lookupContext(s.Name)
+	if err != nil {
+		return s, err
+	}
+
+	if err := s.Validate(); err != nil {
+		return s, err
+	}
+
+	sc.m.Lock()
+	defer sc.m.Unlock()
+
+	if sc.deleted {
+		return s, scheduleNotFound(s.Name)
+	}
+
+	sc.Cron = s.Cron
+	sc.TimeZone = s.TimeZone
+	sc.Cmd = s.Cmd
+	sc.Args = slices.Clone(s.Args)
+	sc.Concurrency = s.Concurrency
+	sc.SuccessfulHistoryLimit = s.SuccessfulHistoryLimit
+	sc.FailedHistoryLimit = s.FailedHistoryLimit
+	sc.arm(m.exe, time.Now())
+
+	slog.Info("Schedule updated", slog.Any("schedule", sc.LogValue()))
+
+	return sc.Schedule.clone(), nil
+}
+
+// Delete disarms and removes a [Schedule]. Jobs started by the Schedule
+// are not stopped or removed.
+//
+// [ErrNotFound] is returned if no Schedule exists with the given name.
+func (m *Manager) Delete(name string) error {
+	m.mu.Lock()
+	sc, err := m.lookupContextLocked(name)
+	if err == nil {
+		delete(m.scheds, name)
+	}
+	m.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	sc.m.Lock()
+	defer sc.m.Unlock()
+	sc.deleted = true
+	if sc.timer != nil {
+		sc.timer.Stop()
+	}
+
+	slog.Info("Schedule deleted", slog.Any("schedule", sc.LogValue()))
+	return nil
+}
+
+// lookupContext is a thread-safe method for finding schedContext by name.
+func (m *Manager) lookupContext(name string) (*schedContext, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lookupContextLocked(name)
+}
+
+// lookupContextLocked finds a schedContext by name. m.mu must be held.
+func (m *Manager) lookupContextLocked(name string) (*schedContext, error) {
+	sc, ok := m.scheds[name]
+	if !ok {
+		return nil, scheduleNotFound(name)
+	}
+	return sc, nil
+}
@@ -0,0 +1,218 @@
+package schedule
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/work"
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+// fakeExecutor runs no processes. Jobs stay running until finish is called.
+type fakeExecutor struct {
+	mu      sync.Mutex
+	jobs    map[string]work.Job
+	started []string
+	stopped []string
+	// starting is called by Start, if set, as if the sandbox of the Job were set up.
+	starting func(j work.Job)
+}
+
+func newFakeExecutor() *fakeExecutor {
+	return &fakeExecutor{jobs: make(map[string]work.Job)}
+}
+
+func (f *fakeExecutor) Start(j work.Job) (work.Job, error) {
+	if f.starting != nil {
+		f.starting(j)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	j.State = work.Running
+	f.jobs[j.Name] = j
+	f.started = append(f.started, j.Name)
+	return j, nil
+}
+
+func (f *fakeExecutor) Stop(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stopped = append(f.stopped, name)
+	return nil
+}
+
+func (f *fakeExecutor) Lookup(name string) (work.Job, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	j, ok := f.jobs[name]
+	if !ok {
+		return j, &work.ErrJobNotFound{}
+	}
+	return j, nil
+}
+
+func (f *fakeExecutor) Remove(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.jobs, name)
+	return nil
+}
+
+// finish terminates the Job with the given name.
+func (f *fakeExecutor) finish(name string, state work.JobState) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	j := f.jobs[name]
+	j.State = state
+	f.jobs[name] = j
+}
+
+// fireLocked fires sc while holding its lock, like its timer.
+func fireLocked(sc *schedContext, exe Executor, now time.Time) {
+	sc.m.Lock()
+	defer sc.m.Unlock()
+	sc.fire(exe, now)
+}
+
+// newContext creates a schedContext that is never armed.
+func newContext(t *testing.T, policy ConcurrencyPolicy) *schedContext {
+	t.Helper()
+	s := NewSchedule("users/test", "@hourly", "echo", nil)
+	s.Concurrency = policy
+	s.SuccessfulHistoryLimit = 2
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	return &schedContext{Schedule: *s}
+}
+
+func Test_schedContext_fire(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		policy      ConcurrencyPolicy
+		wantStarted int
+		wantStopped int
+	}{
+		{name: "allow", policy: Allow, wantStarted: 2},
+		{name: "forbid", policy: Forbid, wantStarted: 1},
+		{name: "replace", policy: Replace, wantStarted: 2, wantStopped: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exe := newFakeExecutor()
+			sc := newContext(t, tt.policy)
+
+			now := time.Now()
+			fireLocked(sc, exe, now)
+			fireLocked(sc, exe, now.Add(time.Hour))
+
+			if got := len(exe.started); got != tt.wantStarted {
+				t.Errorf("schedContext.fire() started %v jobs, want %v", got, tt.wantStarted)
+			}
+			if got := len(exe.stopped); got != tt.wantStopped {
+				t.Errorf("schedContext.fire() stopped %v jobs, want %v", got, tt.wantStopped)
+			}
+			if !slices.Equal(sc.Jobs, exe.started) {
+				t.Errorf("schedContext.fire() Jobs = %v, want %v", sc.Jobs, exe.started)
+			}
+		})
+	}
+}
+
+func Test_schedContext_fireUnlocked(t *testing.T) {
+	t.Parallel()
+	exe := newFakeExecutor()
+	sc := newContext(t, Allow)
+
+	var got Schedule
+	exe.starting = func(work.Job) {
+		// the schedule must not be locked while its Job is started
+		got = sc.scheduleSafe()
+	}
+	now := time.Now()
+	fireLocked(sc, exe, now)
+
+	if !got.LastRunTime.Equal(now) || len(got.Jobs) != 0 {
+		t.Errorf("schedContext.fire() while starting = %+v, want last run %v without jobs", got, now)
+	}
+	if !slices.Equal(sc.Jobs, exe.started) {
+		t.Errorf("schedContext.fire() Jobs = %v, want %v", sc.Jobs, exe.started)
+	}
+}
+
+func Test_schedContext_refresh(t *testing.T) {
+	t.Parallel()
+	exe := newFakeExecutor()
+	sc := newContext(t, Allow)
+
+	for range 5 {
+		fireLocked(sc, exe, time.Now())
+	}
+	jobs := slices.Clone(exe.started)
+	exe.finish(jobs[0], work.Completed)
+	exe.finish(jobs[1], work.Failed)
+	exe.finish(jobs[2], work.Completed)
+	exe.finish(jobs[3], work.Stopped)
+	exe.finish(jobs[4], work.Completed)
+
+	if active := sc.refresh(exe); len(active) != 0 {
+		t.Errorf("schedContext.refresh() active = %v, want none", active)
+	}
+
+	want := []string{jobs[2], jobs[3], jobs[4]}
+	if !slices.Equal(sc.Jobs, want) {
+		t.Errorf("schedContext.refresh() Jobs = %v, want %v", sc.Jobs, want)
+	}
+	for _, name := range []string{jobs[0], jobs[1]} {
+		if _, err := exe.Lookup(name); err == nil {
+			t.Errorf("schedContext.refresh() did not remove %v", name)
+		}
+	}
+}
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+	m := NewManager(newFakeExecutor())
+
+	s, err := m.Create(*NewSchedule("users/test", "@yearly", "echo", nil))
+	if err != nil {
+		t.Fatalf("Manager.Create() error = %v", err)
+	}
+	if s.NextRunTime.IsZero() {
+		t.Error("Manager.Create() NextRunTime was not set")
+	}
+	if _, err := m.Create(*NewSchedule("users/other", "@yearly", "echo", nil)); err != nil {
+		t.Fatalf("Manager.Create() error = %v", err)
+	}
+
+	if got := m.List("users/test"); len(got) != 1 || got[0].Name != s.Name {
+		t.Errorf("Manager.List() = %v, want [%v]", got, s.Name)
+	}
+
+	s.Cron = "bogus"
+	if _, err := m.Update(s); !utils.ErrorTextContains(t, "invalid schedule")(err) {
+		t.Errorf("Manager.Update() error = %v", err)
+	}
+
+	s.Cron = "@monthly"
+	s.Concurrency = Forbid
+	if s, err = m.Update(s); err != nil {
+		t.Fatalf("Manager.Update() error = %v", err)
+	}
+	if s.Cron != "@monthly" || s.Concurrency != Forbid {
+		t.Errorf("Manager.Update() = %+v", s)
+	}
+
+	if err := m.Delete(s.Name); err != nil {
+		t.Fatalf("Manager.Delete() error = %v", err)
+	}
+	if _, err := m.Lookup(s.Name); !utils.ErrorTextContains(t, "no schedule found")(err) {
+		t.Errorf("Manager.Lookup() error = %v", err)
+	}
+	if err := m.Delete(s.Name); !utils.ErrorTextContains(t, "no schedule found")(err) {
+		t.Errorf("Manager.Delete() error = %v", err)
+	}
+}
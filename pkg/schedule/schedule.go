@@ -0,0 +1,145 @@
+package schedule
+
+import (
+	"fmt"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+// ConcurrencyPolicy determines what happens if a [Schedule] fires
+// while Jobs from a previous run are still active.
+type ConcurrencyPolicy string
+
+const (
+	// Start a new Job regardless of any active Jobs.
+	Allow ConcurrencyPolicy = "CONCURRENCY_POLICY_ALLOW"
+	// Skip this run if any Job is still active.
+	Forbid ConcurrencyPolicy = "CONCURRENCY_POLICY_FORBID"
+	// Stop all active Jobs, then start a new Job.
+	Replace ConcurrencyPolicy = "CONCURRENCY_POLICY_REPLACE"
+)
+
+const (
+	// DefaultSuccessfulHistoryLimit is the number of completed Jobs kept if unset.
+	DefaultSuccessfulHistoryLimit = 3
+	// DefaultFailedHistoryLimit is the number of failed Jobs kept if unset.
+	DefaultFailedHistoryLimit = 1
+)
+
+// Schedule materializes a new Job every time the cron expression fires.
+type Schedule struct {
+	Name string
+	// Owner that created this Schedule.
+	Owner string
+	// Cron is a standard 5-field cron expression, or a descriptor such as @hourly.
+	Cron string
+	// TimeZone is an IANA time zone name used to evaluate Cron.
+	// Defaults to UTC if empty.
+	TimeZone string
+	// Cmd path to an executable to run for each Job.
+	Cmd string
+	// Args passed to the subprocess.
+	Args []string
+	// Concurrency determines how overlapping runs are handled.
+	Concurrency ConcurrencyPolicy
+	// SuccessfulHistoryLimit is the number of completed Jobs to keep.
+	// Older Jobs are removed, including their output.
+	SuccessfulHistoryLimit int
+	// FailedHistoryLimit is the number of failed or stopped Jobs to keep.
+	// Older Jobs are removed, including their output.
+	FailedHistoryLimit int
+	// Jobs lists the resource names of all retained Jobs, oldest first.
+	Jobs []string
+	// CreateTime is the time the Schedule was created.
+	CreateTime time.Time
+	// LastRunTime is the last time the Schedule fired.
+	LastRunTime time.Time
+	// NextRunTime is the next time the Schedule fires.
+	NextRunTime time.Time
+}
+
+// NewSchedule creates a [Schedule] with a randomly generated UUID
+// and the given owner, cron expression, cmd, and args.
+func NewSchedule(owner string, expr string, cmd string, args []string) *Schedule {
+	return &Schedule{
+		Name:  path.Join(owner, "/schedules/", uuid.New().String()),
+		Owner: owner,
+		Cron:  expr,
+		Cmd:   cmd,
+		Args:  args,
+	}
+}
+
+// Validate checks the [Schedule] and fills in all defaults.
+func (s *Schedule) Validate() error {
+	if strings.Contains(s.Cron, "TZ=") {
+		return invalidSchedule("time zones must be set with the time zone field")
+	}
+	if _, err := cron.ParseStandard(s.Cron); err != nil {
+		return invalidSchedule(fmt.Sprintf("cron expression '%s': %v", s.Cron, err))
+	}
+	if _, err := time.LoadLocation(s.TimeZone); err != nil {
+		return invalidSchedule(fmt.Sprintf("time zone '%s': %v", s.TimeZone, err))
+	}
+	if s.Cmd == "" {
+		return invalidSchedule("command is required")
+	}
+
+	switch s.Concurrency {
+	case "":
+		s.Concurrency = Allow
+	case Allow, Forbid, Replace:
+	default:
+		return invalidSchedule(fmt.Sprintf("concurrency policy '%s'", s.Concurrency))
+	}
+
+	if s.SuccessfulHistoryLimit < 0 || s.FailedHistoryLimit < 0 {
+		return invalidSchedule("history limits must not be negative")
+	}
+	if s.SuccessfulHistoryLimit == 0 {
+		s.SuccessfulHistoryLimit = DefaultSuccessfulHistoryLimit
+	}
+	if s.FailedHistoryLimit == 0 {
+		s.FailedHistoryLimit = DefaultFailedHistoryLimit
+	}
+
+	return nil
+}
+
+// next returns the first time the [Schedule] fires after t.
+// The Schedule must be valid.
+func (s *Schedule) next(t time.Time) time.Time {
+	sched, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.Time{}
+	}
+	return sched.Next(t.In(loc))
+}
+
+// clone returns a deep copy of s that is safe to hand out.
+func (s Schedule) clone() Schedule {
+	s.Jobs = slices.Clone(s.Jobs)
+	return s
+}
+
+// LogValue implements slog.LogValuer.
+func (s Schedule) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("owner", s.Owner),
+		slog.String("name", s.Name),
+		slog.String("cron", s.Cron),
+		slog.String("tz", s.TimeZone),
+		slog.String("cmd", s.Cmd),
+		slog.Any("args", s.Args),
+	)
+}
@@ -0,0 +1,128 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestSchedule_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		expr    string
+		tz      string
+		cmd     string
+		policy  ConcurrencyPolicy
+		wantErr func(error) bool
+	}{
+		{
+			name:    "valid",
+			expr:    "*/5 * * * *",
+			tz:      "America/New_York",
+			cmd:     "echo",
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "descriptor",
+			expr:    "@hourly",
+			cmd:     "echo",
+			policy:  Replace,
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "invalid cron",
+			expr:    "* * *",
+			cmd:     "echo",
+			wantErr: utils.ErrorTextContains(t, "cron expression"),
+		},
+		{
+			name:    "inline time zone",
+			expr:    "CRON_TZ=UTC * * * * *",
+			cmd:     "echo",
+			wantErr: utils.ErrorTextContains(t, "time zone field"),
+		},
+		{
+			name:    "invalid time zone",
+			expr:    "* * * * *",
+			tz:      "Mars/Olympus_Mons",
+			cmd:     "echo",
+			wantErr: utils.ErrorTextContains(t, "time zone"),
+		},
+		{
+			name:    "missing command",
+			expr:    "* * * * *",
+			wantErr: utils.ErrorTextContains(t, "command is required"),
+		},
+		{
+			name:    "invalid policy",
+			expr:    "* * * * *",
+			cmd:     "echo",
+			policy:  "CONCURRENCY_POLICY_SOMETIMES",
+			wantErr: utils.ErrorTextContains(t, "concurrency policy"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSchedule("users/test", tt.expr, tt.cmd, nil)
+			s.TimeZone = tt.tz
+			s.Concurrency = tt.policy
+			if err := s.Validate(); !tt.wantErr(err) {
+				t.Errorf("Schedule.Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestSchedule_Validate_defaults(t *testing.T) {
+	t.Parallel()
+	s := NewSchedule("users/test", "@daily", "echo", nil)
+	if err := s.Validate(); err != nil {
+		t.Fatalf("Schedule.Validate() error = %v", err)
+	}
+
+	if s.Concurrency != Allow {
+		t.Errorf("Schedule.Validate() Concurrency = %v, want %v", s.Concurrency, Allow)
+	}
+	if s.SuccessfulHistoryLimit != DefaultSuccessfulHistoryLimit {
+		t.Errorf("Schedule.Validate() SuccessfulHistoryLimit = %v, want %v", s.SuccessfulHistoryLimit, DefaultSuccessfulHistoryLimit)
+	}
+	if s.FailedHistoryLimit != DefaultFailedHistoryLimit {
+		t.Errorf("Schedule.Validate() FailedHistoryLimit = %v, want %v", s.FailedHistoryLimit, DefaultFailedHistoryLimit)
+	}
+}
+
+func TestSchedule_next(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		tz   string
+		want time.Time
+	}{
+		{
+			name: "utc",
+			expr: "0 9 * * *",
+			want: time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "time zone",
+			expr: "0 9 * * *",
+			tz:   "America/New_York",
+			// 09:00 EST is 14:00 UTC, which is still today
+			want: time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSchedule("users/test", tt.expr, "echo", nil)
+			s.TimeZone = tt.tz
+			if got := s.next(now); !got.Equal(tt.want) {
+				t.Errorf("Schedule.next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Package seccomp restricts the system calls available to a process with seccomp-BPF.
+//
+// Profiles use a subset of the JSON format of Docker seccomp profiles, for example:
+//
+//	{
+//		"defaultAction": "SCMP_ACT_ERRNO",
+//		"syscalls": [
+//			{"names": ["read", "write", "exit_group"], "action": "SCMP_ACT_ALLOW"},
+//			{"names": ["ptrace"], "action": "SCMP_ACT_KILL_PROCESS"}
+//		]
+//	}
+//
+// Filtering on system call arguments is not supported. Profiles are rejected if
+// they set any other field, so a profile never silently allows more than it says.
+//
+//go:generate go run mksyscalls.go
+package seccomp
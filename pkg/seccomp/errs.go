@@ -0,0 +1,17 @@
+package seccomp
+
+import "fmt"
+
+func invalidProfile(reason string) *ErrInvalidProfile {
+	return &ErrInvalidProfile{reason}
+}
+
+// ErrInvalidProfile is returned if a [Profile] is malformed.
+type ErrInvalidProfile struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidProfile) Error() string {
+	return fmt.Sprintf("invalid seccomp profile: %s", e.reason)
+}
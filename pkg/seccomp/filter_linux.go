@@ -0,0 +1,137 @@
+package seccomp
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Offsets of the fields of struct seccomp_data read by a filter.
+// See: https://man7.org/linux/man-pages/man2/seccomp.2.html
+const (
+	offsetNR   = 0
+	offsetArch = 4
+)
+
+// x32SyscallBit is set in the numbers of system calls made with the x32 ABI on amd64.
+const x32SyscallBit = 0x40000000
+
+// arch describes the system calls of a supported GOARCH.
+type arch struct {
+	// audit identifies the architecture in struct seccomp_data.
+	audit    uint32
+	syscalls map[string]uint32
+	// x32 is set if the x32 ABI shares the audit architecture, and must be rejected.
+	x32 bool
+}
+
+var arches = map[string]arch{
+	"amd64": {audit: unix.AUDIT_ARCH_X86_64, syscalls: syscallsAMD64, x32: true},
+	"arm64": {audit: unix.AUDIT_ARCH_AARCH64, syscalls: syscallsARM64},
+}
+
+// Load applies p to the calling thread, and is inherited by every thread
+// and process it starts afterwards. A loaded filter cannot be removed.
+//
+// The caller must lock its goroutine to the OS thread, and never unlock it,
+// so the runtime discards the thread instead of reusing it for other goroutines.
+// The caller must have CAP_SYS_ADMIN in its user namespace, or set no_new_privs.
+func Load(p Profile) error {
+	filter, err := p.compile(runtime.GOARCH)
+	if err != nil {
+		return err
+	}
+	prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	// without SECCOMP_FILTER_FLAG_TSYNC, other threads are not filtered
+	if _, _, errno := unix.Syscall(unix.SYS_SECCOMP, unix.SECCOMP_SET_MODE_FILTER, 0, uintptr(unsafe.Pointer(&prog))); errno != 0 {
+		return fmt.Errorf("failed to load seccomp filter: %w", errno)
+	}
+	return nil
+}
+
+// compile p into a BPF program for goarch. System calls unknown on goarch are skipped.
+// System calls made with any other architecture, such as 32-bit calls on a 64-bit host,
+// kill the process.
+func (p Profile) compile(goarch string) ([]unix.SockFilter, error) {
+	a, ok := arches[goarch]
+	if !ok {
+		return nil, fmt.Errorf("seccomp is not supported on %s", goarch)
+	}
+
+	// the first rule naming a system call wins
+	actions := make(map[uint32]uint32)
+	for _, r := range p.Syscalls {
+		for _, name := range r.Names {
+			nr, ok := a.syscalls[name]
+			if _, seen := actions[nr]; ok && !seen {
+				actions[nr] = ret(r.Action, r.ErrnoRet)
+			}
+		}
+	}
+	nrs := make([]uint32, 0, len(actions))
+	for nr := range actions {
+		nrs = append(nrs, nr)
+	}
+	sort.Slice(nrs, func(i, j int) bool { return nrs[i] < nrs[j] })
+
+	filter := []unix.SockFilter{
+		load(offsetArch),
+		jump(unix.BPF_JEQ, a.audit, 1, 0),
+		retK(unix.SECCOMP_RET_KILL_PROCESS),
+		load(offsetNR),
+	}
+	if a.x32 {
+		filter = append(filter,
+			jump(unix.BPF_JGE, x32SyscallBit, 0, 1),
+			retK(unix.SECCOMP_RET_KILL_PROCESS),
+		)
+	}
+	for _, nr := range nrs {
+		filter = append(filter,
+			jump(unix.BPF_JEQ, nr, 0, 1),
+			retK(actions[nr]),
+		)
+	}
+	filter = append(filter, retK(ret(p.DefaultAction, p.DefaultErrnoRet)))
+
+	if len(filter) > unix.BPF_MAXINSNS {
+		return nil, invalidProfile(fmt.Sprintf("filter has %d instructions, at most %d are allowed", len(filter), unix.BPF_MAXINSNS))
+	}
+	return filter, nil
+}
+
+// ret returns the return value of a filter for the action a.
+func ret(a Action, errno uint32) uint32 {
+	switch a {
+	case ActAllow:
+		return unix.SECCOMP_RET_ALLOW
+	case ActLog:
+		return unix.SECCOMP_RET_LOG
+	case ActErrno:
+		if errno == 0 {
+			errno = uint32(unix.EPERM)
+		}
+		return unix.SECCOMP_RET_ERRNO | errno&unix.SECCOMP_RET_DATA
+	case ActKill, ActKillThread:
+		return unix.SECCOMP_RET_KILL_THREAD
+	}
+	return unix.SECCOMP_RET_KILL_PROCESS
+}
+
+// load the 32 bit word at offset of struct seccomp_data.
+func load(offset uint32) unix.SockFilter {
+	return unix.SockFilter{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: offset}
+}
+
+// jump by jt instructions if the loaded word compares true to k using op, or jf otherwise.
+func jump(op uint16, k uint32, jt uint8, jf uint8) unix.SockFilter {
+	return unix.SockFilter{Code: unix.BPF_JMP | op | unix.BPF_K, K: k, Jt: jt, Jf: jf}
+}
+
+// retK returns k from the filter.
+func retK(k uint32) unix.SockFilter {
+	return unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: k}
+}
@@ -0,0 +1,82 @@
+package seccomp
+
+import (
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// run evaluates filter for a system call, supporting only the instructions emitted by compile.
+func run(t *testing.T, filter []unix.SockFilter, audit uint32, nr uint32) uint32 {
+	t.Helper()
+	var acc uint32
+	for pc := 0; pc < len(filter); pc++ {
+		ins := filter[pc]
+		switch ins.Code {
+		case unix.BPF_LD | unix.BPF_W | unix.BPF_ABS:
+			acc = map[uint32]uint32{offsetNR: nr, offsetArch: audit}[ins.K]
+		case unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K:
+			ok := acc == ins.K
+			if ins.Code&unix.BPF_JGE == unix.BPF_JGE {
+				ok = acc >= ins.K
+			}
+			if ok {
+				pc += int(ins.Jt)
+			} else {
+				pc += int(ins.Jf)
+			}
+		case unix.BPF_RET | unix.BPF_K:
+			return ins.K
+		default:
+			t.Fatalf("unexpected instruction %+v", ins)
+		}
+	}
+	t.Fatal("filter did not return")
+	return 0
+}
+
+func TestProfile_compile(t *testing.T) {
+	t.Parallel()
+	p := Profile{
+		DefaultAction: ActErrno,
+		Syscalls: []Rule{
+			{Names: []string{"execve", "read", "arch_prctl"}, Action: ActAllow},
+			// shadowed by the first rule
+			{Names: []string{"read", "ptrace"}, Action: ActKillProcess},
+			{Names: []string{"ioctl"}, Action: ActErrno, ErrnoRet: uint32(unix.ENOTTY)},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		arch  string
+		audit uint32
+		nr    uint32
+		want  uint32
+	}{
+		{name: "allow", arch: "amd64", audit: unix.AUDIT_ARCH_X86_64, nr: syscallsAMD64["read"], want: unix.SECCOMP_RET_ALLOW},
+		{name: "first rule wins", arch: "amd64", audit: unix.AUDIT_ARCH_X86_64, nr: syscallsAMD64["ptrace"], want: unix.SECCOMP_RET_KILL_PROCESS},
+		{name: "errno", arch: "amd64", audit: unix.AUDIT_ARCH_X86_64, nr: syscallsAMD64["ioctl"], want: unix.SECCOMP_RET_ERRNO | uint32(unix.ENOTTY)},
+		{name: "default", arch: "amd64", audit: unix.AUDIT_ARCH_X86_64, nr: syscallsAMD64["mount"], want: unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)},
+		{name: "x32", arch: "amd64", audit: unix.AUDIT_ARCH_X86_64, nr: x32SyscallBit | syscallsAMD64["read"], want: unix.SECCOMP_RET_KILL_PROCESS},
+		{name: "i386", arch: "amd64", audit: unix.AUDIT_ARCH_I386, nr: 3, want: unix.SECCOMP_RET_KILL_PROCESS},
+		{name: "arm64", arch: "arm64", audit: unix.AUDIT_ARCH_AARCH64, nr: syscallsARM64["read"], want: unix.SECCOMP_RET_ALLOW},
+		{name: "arm64 default", arch: "arm64", audit: unix.AUDIT_ARCH_AARCH64, nr: syscallsARM64["mount"], want: unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			filter, err := p.compile(tt.arch)
+			if err != nil {
+				t.Fatalf("Profile.compile() error = %v", err)
+			}
+			if got := run(t, filter, tt.audit, tt.nr); got != tt.want {
+				t.Errorf("Profile.compile() returns %#x, want %#x", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := p.compile("riscv64"); err == nil {
+		t.Errorf("Profile.compile() should error on an unsupported architecture")
+	}
+}
@@ -0,0 +1,86 @@
+//go:build ignore
+
+// mksyscalls generates the system call tables of every supported architecture
+// from the system call numbers of golang.org/x/sys/unix.
+//
+// Usage:
+//
+//	go run mksyscalls.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// arches maps each supported GOARCH to the audit architecture naming its table.
+var arches = []struct{ goarch, audit string }{
+	{"amd64", "x86_64"},
+	{"arm64", "aarch64"},
+}
+
+// renames maps names in golang.org/x/sys/unix to the kernel names used by seccomp profiles.
+var renames = map[string]string{
+	"fstatat": "newfstatat",
+}
+
+var sysnum = regexp.MustCompile(`^\s+SYS_([A-Z0-9_]+)\s+=\s+(\d+)$`)
+
+func main() {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "golang.org/x/sys").Output()
+	if err != nil {
+		log.Fatalf("failed to find golang.org/x/sys: %v", err)
+	}
+	dir := strings.TrimSpace(string(out))
+
+	for _, arch := range arches {
+		if err := generate(filepath.Join(dir, "unix", "zsysnum_linux_"+arch.goarch+".go"), arch.goarch, arch.audit); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func generate(path string, goarch string, audit string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by mksyscalls.go from golang.org/x/sys/unix; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package seccomp\n\n")
+	fmt.Fprintf(&buf, "// syscalls%s maps the name of every %s system call to its number.\n", strings.ToUpper(goarch), goarch)
+	fmt.Fprintf(&buf, "var syscalls%s = map[string]uint32{\n", strings.ToUpper(goarch))
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		m := sysnum.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		name := strings.ToLower(m[1])
+		if r, ok := renames[name]; ok {
+			name = r
+		}
+		fmt.Fprintf(&buf, "\t%q: %s,\n", name, m[2])
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("zsyscalls_"+audit+".go", src, 0o644)
+}
@@ -0,0 +1,179 @@
+package seccomp
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+)
+
+// Action is taken when a process makes a system call matched by a [Profile].
+type Action string
+
+const (
+	// ActAllow allows the system call.
+	ActAllow Action = "SCMP_ACT_ALLOW"
+	// ActErrno fails the system call with an errno, EPERM unless set by the rule.
+	ActErrno Action = "SCMP_ACT_ERRNO"
+	// ActLog allows the system call, and logs it to the audit log.
+	ActLog Action = "SCMP_ACT_LOG"
+	// ActKillThread kills the thread with SIGSYS.
+	ActKillThread Action = "SCMP_ACT_KILL_THREAD"
+	// ActKill is an alias of [ActKillThread], as in libseccomp.
+	ActKill Action = "SCMP_ACT_KILL"
+	// ActKillProcess kills every thread of the process with SIGSYS.
+	ActKillProcess Action = "SCMP_ACT_KILL_PROCESS"
+)
+
+// Names of the built-in profiles.
+const (
+	// Default blocks system calls that are dangerous or require capabilities the sandbox
+	// does not have, similar to the default profile of Docker. Blocked calls fail with EPERM.
+	Default = "default"
+	// Strict allows only the system calls needed by simple programs that compute
+	// and read or write files, and kills the process on any other call.
+	Strict = "strict"
+)
+
+//go:embed profiles/*.json
+var builtins embed.FS
+
+// Profile is a seccomp-BPF filter. The action of the first [Rule] naming
+// a system call is taken, or DefaultAction if no rule names it.
+type Profile struct {
+	DefaultAction Action `json:"defaultAction"`
+	// DefaultErrnoRet is the errno returned by an [ActErrno] DefaultAction.
+	DefaultErrnoRet uint32 `json:"defaultErrnoRet,omitempty"`
+	Syscalls        []Rule `json:"syscalls,omitempty"`
+}
+
+// Rule applies an [Action] to the system calls with the given names.
+type Rule struct {
+	Names  []string `json:"names"`
+	Action Action   `json:"action"`
+	// ErrnoRet is the errno returned by an [ActErrno] Action.
+	ErrnoRet uint32 `json:"errnoRet,omitempty"`
+}
+
+// Builtin returns the built-in profile with the given name.
+// Returns false if there is no such profile.
+func Builtin(name string) (Profile, bool) {
+	if name != Default && name != Strict {
+		return Profile{}, false
+	}
+	data, err := builtins.ReadFile("profiles/" + name + ".json")
+	if err != nil {
+		return Profile{}, false
+	}
+	p, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		panic(fmt.Sprintf("built-in seccomp profile %s is invalid: %v", name, err))
+	}
+	return p, true
+}
+
+// ReadFile reads and validates the JSON profile at path.
+func ReadFile(path string) (Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Profile{}, fmt.Errorf("failed to open seccomp profile: %w", err)
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse reads and validates a JSON profile from r.
+//
+// [ErrInvalidProfile] is returned if the profile is malformed, sets unsupported fields,
+// names an unknown system call, or does not allow execve.
+func Parse(r io.Reader) (Profile, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	var p Profile
+	if err := dec.Decode(&p); err != nil {
+		return Profile{}, invalidProfile(err.Error())
+	}
+	return p, p.validate()
+}
+
+// Kills reports whether p kills the process for any system call, with [ActKillThread],
+// [ActKill], or [ActKillProcess]. Other actions never terminate the process: a system call
+// denied with [ActErrno] only fails, so the process may exit however it handles the error.
+func (p Profile) Kills() bool {
+	if kills(p.DefaultAction) {
+		return true
+	}
+	for _, r := range p.Syscalls {
+		if kills(r.Action) {
+			return true
+		}
+	}
+	return false
+}
+
+// kills reports whether a terminates the process with SIGSYS.
+func kills(a Action) bool {
+	return a == ActKillThread || a == ActKill || a == ActKillProcess
+}
+
+// validate checks that every action and system call of p is supported.
+func (p Profile) validate() error {
+	if err := validateAction(p.DefaultAction, p.DefaultErrnoRet); err != nil {
+		return err
+	}
+	for _, r := range p.Syscalls {
+		if err := validateAction(r.Action, r.ErrnoRet); err != nil {
+			return err
+		}
+		if len(r.Names) == 0 {
+			return invalidProfile("every rule must name at least one system call")
+		}
+		for _, name := range r.Names {
+			// profiles may be shared between architectures
+			_, amd64 := syscallsAMD64[name]
+			_, arm64 := syscallsARM64[name]
+			if !amd64 && !arm64 {
+				return invalidProfile(fmt.Sprintf("unknown system call '%s'", name))
+			}
+		}
+	}
+	// the command is started with execve once the filter is loaded
+	if p.action("execve") != ActAllow && p.action("execve") != ActLog {
+		return invalidProfile("execve must be allowed, or the command can never start")
+	}
+	return nil
+}
+
+// validateAction checks that a is supported, and errno is only set for [ActErrno].
+func validateAction(a Action, errno uint32) error {
+	switch a {
+	case ActAllow, ActLog, ActKillThread, ActKill, ActKillProcess:
+		if errno != 0 {
+			return invalidProfile(fmt.Sprintf("errno can only be set for %s", ActErrno))
+		}
+	case ActErrno:
+		// errno is returned in the 16 bit data of the action, but only 1-4095 are errors
+		if errno > 4095 {
+			return invalidProfile(fmt.Sprintf("errno %d is out of range", errno))
+		}
+	case "":
+		return invalidProfile("action must not be empty")
+	default:
+		return invalidProfile(fmt.Sprintf("unsupported action '%s'", a))
+	}
+	return nil
+}
+
+// action returns the action p takes for the system call with the given name.
+func (p Profile) action(name string) Action {
+	for _, r := range p.Syscalls {
+		if slices.Contains(r.Names, name) {
+			return r.Action
+		}
+	}
+	return p.DefaultAction
+}
@@ -0,0 +1,134 @@
+package seccomp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		profile string
+		wantErr func(error) bool
+	}{
+		{
+			name:    "valid",
+			profile: `{"defaultAction": "SCMP_ACT_ERRNO", "syscalls": [{"names": ["execve", "read"], "action": "SCMP_ACT_ALLOW"}, {"names": ["ioctl"], "action": "SCMP_ACT_ERRNO", "errnoRet": 25}]}`,
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "allow by default",
+			profile: `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["ptrace"], "action": "SCMP_ACT_KILL_PROCESS"}]}`,
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "other architecture",
+			profile: `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["arch_prctl", "renameat"], "action": "SCMP_ACT_ERRNO"}]}`,
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "malformed",
+			profile: `{"defaultAction": `,
+			wantErr: utils.ErrorTextContains(t, "invalid seccomp profile"),
+		},
+		{
+			name:    "argument filter",
+			profile: `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["clone"], "action": "SCMP_ACT_ERRNO", "args": [{"index": 0, "value": 2114060288, "op": "SCMP_CMP_MASKED_EQ"}]}]}`,
+			wantErr: utils.ErrorTextContains(t, `unknown field "args"`),
+		},
+		{
+			name:    "missing default action",
+			profile: `{"syscalls": [{"names": ["execve"], "action": "SCMP_ACT_ALLOW"}]}`,
+			wantErr: utils.ErrorTextContains(t, "action must not be empty"),
+		},
+		{
+			name:    "unsupported action",
+			profile: `{"defaultAction": "SCMP_ACT_TRACE"}`,
+			wantErr: utils.ErrorTextContains(t, "unsupported action 'SCMP_ACT_TRACE'"),
+		},
+		{
+			name:    "unknown system call",
+			profile: `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["reed"], "action": "SCMP_ACT_ERRNO"}]}`,
+			wantErr: utils.ErrorTextContains(t, "unknown system call 'reed'"),
+		},
+		{
+			name:    "empty rule",
+			profile: `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": [], "action": "SCMP_ACT_ERRNO"}]}`,
+			wantErr: utils.ErrorTextContains(t, "at least one system call"),
+		},
+		{
+			name:    "errno for allow",
+			profile: `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["read"], "action": "SCMP_ACT_ALLOW", "errnoRet": 1}]}`,
+			wantErr: utils.ErrorTextContains(t, "errno can only be set"),
+		},
+		{
+			name:    "errno out of range",
+			profile: `{"defaultAction": "SCMP_ACT_ERRNO", "defaultErrnoRet": 70000, "syscalls": [{"names": ["execve"], "action": "SCMP_ACT_ALLOW"}]}`,
+			wantErr: utils.ErrorTextContains(t, "out of range"),
+		},
+		{
+			name:    "execve denied",
+			profile: `{"defaultAction": "SCMP_ACT_ERRNO", "syscalls": [{"names": ["read"], "action": "SCMP_ACT_ALLOW"}]}`,
+			wantErr: utils.ErrorTextContains(t, "execve must be allowed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := Parse(strings.NewReader(tt.profile)); !tt.wantErr(err) {
+				t.Errorf("Parse() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestBuiltin(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{Default, Strict} {
+		p, ok := Builtin(name)
+		if !ok {
+			t.Fatalf("Builtin(%q) not found", name)
+		}
+		if got := p.action("execve"); got != ActAllow {
+			t.Errorf("Builtin(%q) execve action = %v, want %v", name, got, ActAllow)
+		}
+		if got := p.action("kexec_load"); got == ActAllow {
+			t.Errorf("Builtin(%q) kexec_load action = %v, want it denied", name, got)
+		}
+	}
+	if _, ok := Builtin("profiles/default"); ok {
+		t.Errorf("Builtin() found a profile by path")
+	}
+}
+
+func TestProfile_Kills(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		profile string
+		want    bool
+	}{
+		{name: Default, want: false},
+		{name: Strict, want: true},
+		{name: "kill rule", profile: `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["ptrace"], "action": "SCMP_ACT_KILL"}]}`, want: true},
+		{name: "errno rule", profile: `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["ptrace"], "action": "SCMP_ACT_ERRNO"}]}`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p, ok := Builtin(tt.name)
+			if !ok {
+				var err error
+				if p, err = Parse(strings.NewReader(tt.profile)); err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+			}
+			if got := p.Kills(); got != tt.want {
+				t.Errorf("Profile.Kills() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
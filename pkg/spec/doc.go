@@ -0,0 +1,10 @@
+// Package spec implements the subset of the OCI runtime specification supported by Telehandler.
+//
+// A [Spec] describes the sandbox of a single attempt of a [work.Job]: its process,
+// mounts, namespaces, and resources. The Executor passes it to the reexec process
+// over a file descriptor, and clients can submit the config.json of an OCI bundle,
+// which is converted into a Job. Fields outside of the subset are rejected by [Parse],
+// instead of being silently ignored.
+//
+// See: https://github.com/opencontainers/runtime-spec/blob/main/config.md
+package spec
@@ -0,0 +1,17 @@
+package spec
+
+import "fmt"
+
+func invalidSpec(reason string) *ErrInvalidSpec {
+	return &ErrInvalidSpec{reason}
+}
+
+// ErrInvalidSpec is returned if a [Spec] is malformed, or sets a field that is not supported.
+type ErrInvalidSpec struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidSpec) Error() string {
+	return fmt.Sprintf("invalid runtime spec: %s", e.reason)
+}
@@ -0,0 +1,256 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/drrev/telehandler/pkg/seccomp"
+)
+
+// Version of the runtime specification written by Telehandler.
+// Any 1.x version is accepted by [Parse].
+const Version = "1.2.0"
+
+// Types of the namespaces of a [Linux] sandbox.
+const (
+	NamespacePID     = "pid"
+	NamespaceNetwork = "network"
+	NamespaceMount   = "mount"
+	NamespaceIPC     = "ipc"
+	NamespaceUTS     = "uts"
+	NamespaceUser    = "user"
+	NamespaceCgroup  = "cgroup"
+	NamespaceTime    = "time"
+)
+
+// Spec is the subset of the OCI runtime configuration, config.json, supported by Telehandler.
+type Spec struct {
+	// Version of the runtime specification the Spec follows, such as "1.2.0".
+	Version string `json:"ociVersion"`
+	// Process is the command run in the sandbox.
+	Process *Process `json:"process,omitempty"`
+	// Hostname of the sandbox, within its UTS namespace.
+	Hostname string `json:"hostname,omitempty"`
+	// Mounts are performed in order, within the mount namespace of the sandbox.
+	Mounts []Mount `json:"mounts,omitempty"`
+	// Annotations hold arbitrary metadata, and the settings of Telehandler that
+	// have no equivalent in the specification.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Linux configures the namespaces, cgroup, and system call filter of the sandbox.
+	Linux *Linux `json:"linux,omitempty"`
+}
+
+// Process is the command run in the sandbox of a [Spec].
+type Process struct {
+	// User the command runs as.
+	User User `json:"user"`
+	// Args is the command, and its args. Must not be empty.
+	Args []string `json:"args"`
+	// Env lists the KEY=VALUE environment variables of the command.
+	Env []string `json:"env,omitempty"`
+	// Cwd is the working directory of the command.
+	Cwd string `json:"cwd"`
+	// Capabilities of the command.
+	Capabilities *Capabilities `json:"capabilities,omitempty"`
+	// Rlimits are the resource limits of the command.
+	Rlimits []Rlimit `json:"rlimits,omitempty"`
+	// NoNewPrivileges prevents the command from gaining privileges, such as with setuid binaries.
+	NoNewPrivileges bool `json:"noNewPrivileges,omitempty"`
+}
+
+// User is the uid and gid of a [Process], within its user namespace.
+type User struct {
+	UID uint32 `json:"uid"`
+	GID uint32 `json:"gid"`
+}
+
+// Capabilities are the capability sets of a [Process], named like CAP_NET_RAW.
+// The inheritable and ambient sets are not supported.
+type Capabilities struct {
+	Bounding  []string `json:"bounding,omitempty"`
+	Effective []string `json:"effective,omitempty"`
+	Permitted []string `json:"permitted,omitempty"`
+}
+
+// Rlimit is a resource limit of a [Process], see setrlimit(2).
+type Rlimit struct {
+	// Type of the limit, such as RLIMIT_NOFILE.
+	Type string `json:"type"`
+	Hard uint64 `json:"hard"`
+	Soft uint64 `json:"soft"`
+}
+
+// Mount is performed within the mount namespace of the sandbox.
+type Mount struct {
+	// Destination is the path of the mount in the sandbox.
+	Destination string `json:"destination"`
+	// Type of the filesystem, such as tmpfs, or bind.
+	Type string `json:"type,omitempty"`
+	// Source of the mount, such as a host path for bind mounts.
+	Source string `json:"source,omitempty"`
+	// Options of the mount, such as ro, or size=64m.
+	Options []string `json:"options,omitempty"`
+}
+
+// Linux configures the Linux specific settings of the sandbox.
+type Linux struct {
+	// Namespaces created for the sandbox. Joining existing namespaces is not supported.
+	Namespaces []Namespace `json:"namespaces,omitempty"`
+	// Resources constrain the cgroup of the sandbox.
+	Resources *Resources `json:"resources,omitempty"`
+	// CgroupsPath is the path of the cgroup of the sandbox.
+	CgroupsPath string `json:"cgroupsPath,omitempty"`
+	// Seccomp filters the system calls of the command.
+	Seccomp *seccomp.Profile `json:"seccomp,omitempty"`
+}
+
+// Namespace is created for the sandbox, such as [NamespacePID].
+type Namespace struct {
+	Type string `json:"type"`
+}
+
+// Resources constrain the cgroup of the sandbox.
+type Resources struct {
+	CPU    *CPU    `json:"cpu,omitempty"`
+	Memory *Memory `json:"memory,omitempty"`
+}
+
+// CPU bandwidth of the cgroup, as a quota of microseconds in every period.
+type CPU struct {
+	Quota  *int64  `json:"quota,omitempty"`
+	Period *uint64 `json:"period,omitempty"`
+}
+
+// Memory limits the cgroup.
+type Memory struct {
+	// Limit is the maximum number of bytes of memory.
+	Limit *int64 `json:"limit,omitempty"`
+}
+
+// Parse reads and validates a JSON Spec from r.
+//
+// [ErrInvalidSpec] is returned if the spec is malformed, is not version 1.x,
+// or sets any field that is not part of Spec, which is named by its path,
+// such as "linux.sysctl".
+func Parse(r io.Reader) (Spec, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Spec{}, fmt.Errorf("failed to read runtime spec: %w", err)
+	}
+
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Spec{}, invalidSpec(err.Error())
+	}
+	if err := checkFields(raw, reflect.TypeFor[Spec](), ""); err != nil {
+		return Spec{}, err
+	}
+
+	var s Spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Spec{}, invalidSpec(err.Error())
+	}
+	return s, s.validate()
+}
+
+// checkFields returns an error naming the first field of v, decoded from JSON at path,
+// that has no equivalent in t. Type mismatches are left to encoding/json.
+func checkFields(v any, t reflect.Type, path string) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		// maps, such as annotations, have arbitrary keys
+		if t.Kind() != reflect.Struct {
+			return nil
+		}
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			p := key
+			if path != "" {
+				p = path + "." + key
+			}
+			f, ok := field(t, key)
+			if !ok {
+				return invalidSpec(fmt.Sprintf("unsupported field '%s'", p))
+			}
+			if err := checkFields(v[key], f.Type, p); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if t.Kind() != reflect.Slice {
+			return nil
+		}
+		for i, e := range v {
+			if err := checkFields(e, t.Elem(), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// field returns the field of the struct t that is encoded as the JSON key.
+func field(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name == key {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// validate checks the fields of s that cannot be checked by their type.
+func (s Spec) validate() error {
+	if major, _, _ := strings.Cut(s.Version, "."); major != "1" {
+		return invalidSpec(fmt.Sprintf("ociVersion '%s' is not supported, it must be 1.x", s.Version))
+	}
+	if s.Process == nil || len(s.Process.Args) == 0 || s.Process.Args[0] == "" {
+		return invalidSpec("process.args must not be empty")
+	}
+
+	rlimits := make(map[string]bool, len(s.Process.Rlimits))
+	for _, l := range s.Process.Rlimits {
+		if rlimits[l.Type] {
+			return invalidSpec(fmt.Sprintf("rlimit %s is set more than once", l.Type))
+		}
+		rlimits[l.Type] = true
+		if l.Soft > l.Hard {
+			return invalidSpec(fmt.Sprintf("rlimit %s soft limit must not exceed its hard limit", l.Type))
+		}
+	}
+
+	for i, m := range s.Mounts {
+		if m.Destination == "" {
+			return invalidSpec(fmt.Sprintf("mounts[%d].destination must not be empty", i))
+		}
+	}
+
+	if s.Linux == nil {
+		return nil
+	}
+	namespaces := make(map[string]bool, len(s.Linux.Namespaces))
+	for _, ns := range s.Linux.Namespaces {
+		switch ns.Type {
+		case NamespacePID, NamespaceNetwork, NamespaceMount, NamespaceIPC, NamespaceUTS, NamespaceUser, NamespaceCgroup, NamespaceTime:
+		default:
+			return invalidSpec(fmt.Sprintf("unknown namespace type '%s'", ns.Type))
+		}
+		if namespaces[ns.Type] {
+			return invalidSpec(fmt.Sprintf("namespace %s is listed more than once", ns.Type))
+		}
+		namespaces[ns.Type] = true
+	}
+	if r := s.Linux.Resources; r != nil && r.CPU != nil && (r.CPU.Quota == nil) != (r.CPU.Period == nil) {
+		return invalidSpec("linux.resources.cpu must set both quota and period")
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package spec
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		spec    string
+		want    Spec
+		wantErr func(error) bool
+	}{
+		{
+			name: "valid",
+			spec: `{
+				"ociVersion": "1.0.2-dev",
+				"process": {
+					"user": {"uid": 1000, "gid": 1000},
+					"args": ["sh", "-c", "make"],
+					"env": ["PATH=/usr/bin:/bin"],
+					"cwd": "/src",
+					"capabilities": {"bounding": ["CAP_KILL"], "effective": ["CAP_KILL"], "permitted": ["CAP_KILL"]},
+					"rlimits": [{"type": "RLIMIT_NOFILE", "hard": 1024, "soft": 512}],
+					"noNewPrivileges": true
+				},
+				"mounts": [{"destination": "/tmp", "type": "tmpfs", "source": "tmpfs", "options": ["size=64m"]}],
+				"annotations": {"team": "infra"},
+				"linux": {"resources": {"cpu": {"quota": 50000, "period": 100000}, "memory": {"limit": 268435456}}}
+			}`,
+			want: Spec{
+				Version: "1.0.2-dev",
+				Process: &Process{
+					User:            User{UID: 1000, GID: 1000},
+					Args:            []string{"sh", "-c", "make"},
+					Env:             []string{"PATH=/usr/bin:/bin"},
+					Cwd:             "/src",
+					Capabilities:    &Capabilities{Bounding: []string{"CAP_KILL"}, Effective: []string{"CAP_KILL"}, Permitted: []string{"CAP_KILL"}},
+					Rlimits:         []Rlimit{{Type: "RLIMIT_NOFILE", Hard: 1024, Soft: 512}},
+					NoNewPrivileges: true,
+				},
+				Mounts:      []Mount{{Destination: "/tmp", Type: "tmpfs", Source: "tmpfs", Options: []string{"size=64m"}}},
+				Annotations: map[string]string{"team": "infra"},
+				Linux: &Linux{Resources: &Resources{
+					CPU:    &CPU{Quota: ptr[int64](50000), Period: ptr[uint64](100000)},
+					Memory: &Memory{Limit: ptr[int64](268435456)},
+				}},
+			},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "malformed",
+			spec:    `{"ociVersion": `,
+			wantErr: utils.ErrorTextContains(t, "invalid runtime spec"),
+		},
+		{
+			name:    "unsupported field",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"]}, "hooks": {"prestart": []}}`,
+			wantErr: utils.ErrorTextContains(t, "unsupported field 'hooks'"),
+		},
+		{
+			name:    "unsupported nested field",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "terminal": false}}`,
+			wantErr: utils.ErrorTextContains(t, "unsupported field 'process.terminal'"),
+		},
+		{
+			name:    "unsupported field in a list",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"]}, "mounts": [{"destination": "/tmp", "type": "tmpfs"}, {"destination": "/data", "uidMappings": []}]}`,
+			wantErr: utils.ErrorTextContains(t, "unsupported field 'mounts[1].uidMappings'"),
+		},
+		{
+			name:    "joined namespace",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"]}, "linux": {"namespaces": [{"type": "network", "path": "/proc/1/ns/net"}]}}`,
+			wantErr: utils.ErrorTextContains(t, "unsupported field 'linux.namespaces[0].path'"),
+		},
+		{
+			name:    "wrong type",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": "true"}}`,
+			wantErr: utils.ErrorTextContains(t, "cannot unmarshal string"),
+		},
+		{
+			name:    "unsupported version",
+			spec:    `{"ociVersion": "2.0.0", "process": {"args": ["true"]}}`,
+			wantErr: utils.ErrorTextContains(t, "ociVersion '2.0.0' is not supported"),
+		},
+		{
+			name:    "no process",
+			spec:    `{"ociVersion": "1.2.0"}`,
+			wantErr: utils.ErrorTextContains(t, "process.args must not be empty"),
+		},
+		{
+			name:    "soft limit above hard limit",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "rlimits": [{"type": "RLIMIT_NOFILE", "hard": 10, "soft": 20}]}}`,
+			wantErr: utils.ErrorTextContains(t, "soft limit must not exceed its hard limit"),
+		},
+		{
+			name:    "repeated rlimit",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "rlimits": [{"type": "RLIMIT_CORE", "hard": 0, "soft": 0}, {"type": "RLIMIT_CORE", "hard": 0, "soft": 0}]}}`,
+			wantErr: utils.ErrorTextContains(t, "rlimit RLIMIT_CORE is set more than once"),
+		},
+		{
+			name:    "mount without destination",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"]}, "mounts": [{"type": "tmpfs"}]}`,
+			wantErr: utils.ErrorTextContains(t, "mounts[0].destination must not be empty"),
+		},
+		{
+			name:    "unknown namespace",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"]}, "linux": {"namespaces": [{"type": "net"}]}}`,
+			wantErr: utils.ErrorTextContains(t, "unknown namespace type 'net'"),
+		},
+		{
+			name:    "repeated namespace",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"]}, "linux": {"namespaces": [{"type": "pid"}, {"type": "pid"}]}}`,
+			wantErr: utils.ErrorTextContains(t, "namespace pid is listed more than once"),
+		},
+		{
+			name:    "quota without period",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"]}, "linux": {"resources": {"cpu": {"quota": 50000}}}}`,
+			wantErr: utils.ErrorTextContains(t, "must set both quota and period"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Parse(strings.NewReader(tt.spec))
+			if !tt.wantErr(err) {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
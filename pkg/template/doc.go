@@ -0,0 +1,6 @@
+// Package template stores reusable [work.Job] configurations with typed parameters.
+// A [Template] is instantiated into an ordinary Job by substituting parameter
+// values into its args and env.
+//
+// All templates are managed by the [Manager].
+package template
@@ -0,0 +1,48 @@
+package template
+
+import (
+	"fmt"
+)
+
+func invalidTemplate(reason string) *ErrInvalidTemplate {
+	return &ErrInvalidTemplate{reason}
+}
+
+// ErrInvalidTemplate is returned if a [Template] is malformed.
+type ErrInvalidTemplate struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidTemplate) Error() string {
+	return fmt.Sprintf("invalid template: %s", e.reason)
+}
+
+func invalidParameter(name string, reason string) *ErrInvalidParameter {
+	return &ErrInvalidParameter{name, reason}
+}
+
+// ErrInvalidParameter is returned if a parameter value does not satisfy its [Param].
+type ErrInvalidParameter struct {
+	name   string
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidParameter) Error() string {
+	return fmt.Sprintf("invalid parameter '%s': %s", e.name, e.reason)
+}
+
+func templateNotFound(name string) *ErrNotFound {
+	return &ErrNotFound{name}
+}
+
+// ErrNotFound is returned if no template is found for a given name.
+type ErrNotFound struct {
+	name string
+}
+
+// Error implements error.
+func (e *ErrNotFound) Error() string {
+	return fmt.Sprintf("no template found with name='%v'", e.name)
+}
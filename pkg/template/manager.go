@@ -0,0 +1,98 @@
+package template
+
+import (
+	"cmp"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Manager is a thread-safe [Template] store.
+// Templates are immutable once created.
+//
+// See [NewManager].
+type Manager struct {
+	mu        sync.RWMutex
+	templates map[string]Template
+}
+
+// NewManager creates an initialized [Manager] ready for use.
+func NewManager() *Manager {
+	return &Manager{
+		mu:        sync.RWMutex{},
+		templates: make(map[string]Template),
+	}
+}
+
+// Create validates and stores the given [Template].
+//
+// [ErrInvalidTemplate] is returned if validation fails.
+func (m *Manager) Create(t Template) (Template, error) {
+	if err := t.Validate(); err != nil {
+		return t, err
+	}
+
+	t = t.clone()
+	t.CreateTime = time.Now()
+
+	m.mu.Lock()
+	m.templates[t.Name] = t
+	m.mu.Unlock()
+
+	slog.Info("Template created", slog.Any("template", t.LogValue()))
+
+	return t.clone(), nil
+}
+
+// Lookup returns a copy of any [Template] found. If no Template is found, a [ErrNotFound]
+// is returned and the Template value is zero.
+func (m *Manager) Lookup(name string) (Template, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	t, ok := m.templates[name]
+	if !ok {
+		return Template{}, templateNotFound(name)
+	}
+	return t.clone(), nil
+}
+
+// List returns copies of all Templates owned by owner, oldest first.
+func (m *Manager) List(owner string) []Template {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var out []Template
+	for name, t := range m.templates {
+		if strings.HasPrefix(name, owner+"/") {
+			out = append(out, t.clone())
+		}
+	}
+
+	slices.SortFunc(out, func(a, b Template) int {
+		if c := a.CreateTime.Compare(b.CreateTime); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return out
+}
+
+// Delete removes a [Template]. Jobs started from the Template are not affected.
+//
+// [ErrNotFound] is returned if no Template exists with the given name.
+func (m *Manager) Delete(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.templates[name]
+	if !ok {
+		return templateNotFound(name)
+	}
+	delete(m.templates, name)
+
+	slog.Info("Template deleted", slog.Any("template", t.LogValue()))
+	return nil
+}
@@ -0,0 +1,49 @@
+package template
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+	m := NewManager()
+
+	a, err := m.Create(*NewTemplate("users/alice", "echo", []string{"${msg}"}))
+	if err == nil {
+		t.Fatalf("Manager.Create() should reject undeclared parameters")
+	}
+
+	tmpl := NewTemplate("users/alice", "echo", []string{"${msg}"})
+	tmpl.Params = []Param{{Name: "msg", Default: "hello"}}
+	if a, err = m.Create(*tmpl); err != nil {
+		t.Fatalf("Manager.Create() error = %v", err)
+	}
+	if a.CreateTime.IsZero() || a.Params[0].Type != String {
+		t.Errorf("Manager.Create() = %+v, want defaults filled in", a)
+	}
+
+	// mutating the input must not change the stored template
+	tmpl.Args[0] = "changed"
+	if got, err := m.Lookup(a.Name); err != nil || got.Args[0] != "${msg}" {
+		t.Errorf("Manager.Lookup() = %+v, %v", got, err)
+	}
+
+	if _, err := m.Create(*NewTemplate("users/bob", "true", nil)); err != nil {
+		t.Fatalf("Manager.Create() error = %v", err)
+	}
+	if got := m.List("users/alice"); len(got) != 1 || got[0].Name != a.Name {
+		t.Errorf("Manager.List() = %+v, want only %v", got, a.Name)
+	}
+
+	if err := m.Delete(a.Name); err != nil {
+		t.Fatalf("Manager.Delete() error = %v", err)
+	}
+	var notFound *ErrNotFound
+	if _, err := m.Lookup(a.Name); !errors.As(err, &notFound) {
+		t.Errorf("Manager.Lookup() error = %v, want ErrNotFound", err)
+	}
+	if err := m.Delete(a.Name); !errors.As(err, &notFound) {
+		t.Errorf("Manager.Delete() error = %v, want ErrNotFound", err)
+	}
+}
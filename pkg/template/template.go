@@ -0,0 +1,256 @@
+package template
+
+import (
+	"fmt"
+	"log/slog"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/work"
+	"github.com/google/uuid"
+)
+
+// ParamType constrains the values accepted by a [Param].
+type ParamType string
+
+const (
+	// Any string. This is the default.
+	String ParamType = "PARAMETER_TYPE_STRING"
+	// A base 10 integer.
+	Integer ParamType = "PARAMETER_TYPE_INTEGER"
+	// true or false.
+	Boolean ParamType = "PARAMETER_TYPE_BOOLEAN"
+	// One of [Param.Allowed].
+	Enum ParamType = "PARAMETER_TYPE_ENUM"
+)
+
+// Param declares a typed value that is substituted into a [Template]
+// wherever ${Name} appears in its args or env values.
+type Param struct {
+	// Name of the parameter, a letter or underscore followed by letters, digits, or underscores.
+	Name string
+	// Type of the parameter. Defaults to [String].
+	Type ParamType
+	// Description is shown to users of the Template.
+	Description string
+	// Required parameters must be set when the Template is instantiated.
+	Required bool
+	// Default is used if the parameter is not set. It must satisfy Type.
+	Default string
+	// Allowed lists the accepted values of an [Enum] parameter.
+	Allowed []string
+}
+
+// check validates value against the type of p.
+func (p *Param) check(value string) error {
+	switch p.Type {
+	case Integer:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return invalidParameter(p.Name, fmt.Sprintf("'%s' is not an integer", value))
+		}
+	case Boolean:
+		if value != "true" && value != "false" {
+			return invalidParameter(p.Name, fmt.Sprintf("'%s' is not true or false", value))
+		}
+	case Enum:
+		if !slices.Contains(p.Allowed, value) {
+			return invalidParameter(p.Name, fmt.Sprintf("'%s' is not one of %v", value, p.Allowed))
+		}
+	}
+	return nil
+}
+
+var (
+	// paramNamePattern restricts parameter names to shell-like identifiers.
+	paramNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// paramRefPattern matches parameter references in args and env values.
+	paramRefPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+// Template is a reusable [work.Job] configuration.
+//
+// Only args and env values may reference parameters. The command, limits,
+// and timeout are fixed by the owner of the Template.
+type Template struct {
+	Name string
+	// Owner that created this Template.
+	Owner string
+	// Description is shown to users of the Template.
+	Description string
+	// Cmd path to an executable to run for each Job.
+	Cmd string
+	// Args passed to the subprocess, which may reference parameters.
+	Args []string
+	// Env lists KEY=VALUE environment variables for the subprocess.
+	// Values may reference parameters.
+	Env []string
+	// Limits constrain the resources used by each Job.
+	Limits work.Limits
+	// Timeout is the maximum duration of each Job attempt.
+	Timeout time.Duration
+	// Params declares every parameter referenced by Args and Env.
+	Params []Param
+	// CreateTime is the time the Template was created.
+	CreateTime time.Time
+}
+
+// NewTemplate creates a [Template] with a randomly generated UUID
+// and the given owner, cmd, and args.
+func NewTemplate(owner string, cmd string, args []string) *Template {
+	return &Template{
+		Name:  path.Join(owner, "/jobTemplates/", uuid.New().String()),
+		Owner: owner,
+		Cmd:   cmd,
+		Args:  args,
+	}
+}
+
+// Validate checks the [Template] and fills in all defaults.
+func (t *Template) Validate() error {
+	if t.Cmd == "" {
+		return invalidTemplate("command is required")
+	}
+	if t.Limits.CPU < 0 || t.Limits.Memory < 0 {
+		return invalidTemplate("limits must not be negative")
+	}
+	if t.Timeout < 0 {
+		return invalidTemplate("timeout must not be negative")
+	}
+
+	declared := make(map[string]bool, len(t.Params))
+	for i := range t.Params {
+		p := &t.Params[i]
+		if !paramNamePattern.MatchString(p.Name) {
+			return invalidTemplate(fmt.Sprintf("parameter name '%s' must be a letter or '_' followed by letters, digits, or '_'", p.Name))
+		}
+		if declared[p.Name] {
+			return invalidTemplate(fmt.Sprintf("parameter '%s' is declared more than once", p.Name))
+		}
+		declared[p.Name] = true
+
+		switch p.Type {
+		case "":
+			p.Type = String
+		case String, Integer, Boolean:
+		case Enum:
+			if len(p.Allowed) == 0 {
+				return invalidTemplate(fmt.Sprintf("enum parameter '%s' must list allowed values", p.Name))
+			}
+		default:
+			return invalidTemplate(fmt.Sprintf("parameter '%s' has unknown type '%s'", p.Name, p.Type))
+		}
+		if p.Type != Enum && len(p.Allowed) > 0 {
+			return invalidTemplate(fmt.Sprintf("only enum parameters may list allowed values, '%s' is %s", p.Name, p.Type))
+		}
+
+		if !p.Required {
+			if err := p.check(p.Default); err != nil {
+				return invalidTemplate(fmt.Sprintf("default value: %v", err))
+			}
+		}
+	}
+
+	refs := slices.Clone(t.Args)
+	for _, kv := range t.Env {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" {
+			return invalidTemplate(fmt.Sprintf("environment variable '%s' must be formatted as KEY=VALUE", kv))
+		}
+		refs = append(refs, v)
+	}
+	for _, s := range refs {
+		for _, m := range paramRefPattern.FindAllStringSubmatch(s, -1) {
+			if !declared[m[1]] {
+				return invalidTemplate(fmt.Sprintf("'%s' references undeclared parameter '%s'", s, m[1]))
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolve checks values against the parameters of the [Template], then
+// returns the value of every parameter, with defaults filled in.
+func (t *Template) resolve(values map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(t.Params))
+	for _, p := range t.Params {
+		v, ok := values[p.Name]
+		switch {
+		case ok:
+			if err := p.check(v); err != nil {
+				return nil, err
+			}
+		case p.Required:
+			return nil, invalidParameter(p.Name, "a value is required")
+		default:
+			v = p.Default
+		}
+		resolved[p.Name] = v
+	}
+
+	for name := range values {
+		if _, ok := resolved[name]; !ok {
+			return nil, invalidParameter(name, "not declared by the template")
+		}
+	}
+	return resolved, nil
+}
+
+// Apply configures j to run the [Template] with the given parameter values.
+// The command, args, env, limits, and timeout of j are replaced.
+//
+// [ErrInvalidParameter] is returned if any value is missing, undeclared,
+// or does not match the type of its parameter.
+func (t *Template) Apply(j *work.Job, values map[string]string) error {
+	resolved, err := t.resolve(values)
+	if err != nil {
+		return err
+	}
+
+	expand := func(s string) string {
+		return paramRefPattern.ReplaceAllStringFunc(s, func(ref string) string {
+			return resolved[ref[2:len(ref)-1]]
+		})
+	}
+
+	j.Cmd = t.Cmd
+	j.Args = make([]string, 0, len(t.Args))
+	for _, arg := range t.Args {
+		j.Args = append(j.Args, expand(arg))
+	}
+	j.Env = make([]string, 0, len(t.Env))
+	for _, kv := range t.Env {
+		k, v, _ := strings.Cut(kv, "=")
+		j.Env = append(j.Env, k+"="+expand(v))
+	}
+	j.Limits = t.Limits
+	j.Timeout = t.Timeout
+
+	return nil
+}
+
+// clone returns a deep copy of t that is safe to hand out.
+func (t Template) clone() Template {
+	t.Args = slices.Clone(t.Args)
+	t.Env = slices.Clone(t.Env)
+	t.Params = slices.Clone(t.Params)
+	for i := range t.Params {
+		t.Params[i].Allowed = slices.Clone(t.Params[i].Allowed)
+	}
+	return t
+}
+
+// LogValue implements slog.LogValuer.
+func (t Template) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("owner", t.Owner),
+		slog.String("name", t.Name),
+		slog.String("cmd", t.Cmd),
+		slog.Any("args", t.Args),
+		slog.Int("params", len(t.Params)),
+	)
+}
@@ -0,0 +1,176 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/work"
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestTemplate_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		tmpl    Template
+		wantErr func(error) bool
+	}{
+		{
+			name: "valid",
+			tmpl: Template{
+				Cmd:    "bash",
+				Args:   []string{"-c", "backup ${target} --retries=${retries}"},
+				Env:    []string{"MODE=${mode}"},
+				Params: []Param{{Name: "target", Required: true}, {Name: "retries", Type: Integer, Default: "3"}, {Name: "mode", Type: Enum, Allowed: []string{"full", "incremental"}, Default: "full"}},
+			},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "missing command",
+			tmpl:    Template{},
+			wantErr: utils.ErrorTextContains(t, "command is required"),
+		},
+		{
+			name:    "negative timeout",
+			tmpl:    Template{Cmd: "echo", Timeout: -time.Second},
+			wantErr: utils.ErrorTextContains(t, "timeout must not be negative"),
+		},
+		{
+			name:    "invalid parameter name",
+			tmpl:    Template{Cmd: "echo", Params: []Param{{Name: "a-b"}}},
+			wantErr: utils.ErrorTextContains(t, "parameter name 'a-b'"),
+		},
+		{
+			name:    "duplicate parameter",
+			tmpl:    Template{Cmd: "echo", Params: []Param{{Name: "a"}, {Name: "a"}}},
+			wantErr: utils.ErrorTextContains(t, "declared more than once"),
+		},
+		{
+			name:    "unknown type",
+			tmpl:    Template{Cmd: "echo", Params: []Param{{Name: "a", Type: "PARAMETER_TYPE_FLOAT"}}},
+			wantErr: utils.ErrorTextContains(t, "unknown type"),
+		},
+		{
+			name:    "enum without allowed values",
+			tmpl:    Template{Cmd: "echo", Params: []Param{{Name: "a", Type: Enum, Required: true}}},
+			wantErr: utils.ErrorTextContains(t, "must list allowed values"),
+		},
+		{
+			name:    "invalid default",
+			tmpl:    Template{Cmd: "echo", Params: []Param{{Name: "a", Type: Integer, Default: "many"}}},
+			wantErr: utils.ErrorTextContains(t, "is not an integer"),
+		},
+		{
+			name:    "undeclared reference",
+			tmpl:    Template{Cmd: "echo", Args: []string{"${a}"}},
+			wantErr: utils.ErrorTextContains(t, "undeclared parameter 'a'"),
+		},
+		{
+			name:    "malformed env",
+			tmpl:    Template{Cmd: "echo", Env: []string{"A"}},
+			wantErr: utils.ErrorTextContains(t, "KEY=VALUE"),
+		},
+		{
+			name:    "shell variables are not parameters",
+			tmpl:    Template{Cmd: "bash", Args: []string{"-c", "echo $HOME"}},
+			wantErr: utils.NoError(t),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.tmpl.Validate(); !tt.wantErr(err) {
+				t.Errorf("Template.Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestTemplate_Apply(t *testing.T) {
+	t.Parallel()
+	tmpl := Template{
+		Cmd:     "bash",
+		Args:    []string{"-c", "backup ${target} --retries=${retries} $HOME"},
+		Env:     []string{"MODE=${mode}", "DRY_RUN=${dry_run}"},
+		Limits:  work.Limits{CPU: 500},
+		Timeout: time.Minute,
+		Params: []Param{
+			{Name: "target", Required: true},
+			{Name: "retries", Type: Integer, Default: "3"},
+			{Name: "mode", Type: Enum, Allowed: []string{"full", "incremental"}, Default: "full"},
+			{Name: "dry_run", Type: Boolean, Default: "false"},
+		},
+	}
+	if err := tmpl.Validate(); err != nil {
+		t.Fatalf("Template.Validate() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		values   map[string]string
+		wantArgs []string
+		wantEnv  []string
+		wantErr  func(error) bool
+	}{
+		{
+			name:     "defaults",
+			values:   map[string]string{"target": "/srv"},
+			wantArgs: []string{"-c", "backup /srv --retries=3 $HOME"},
+			wantEnv:  []string{"MODE=full", "DRY_RUN=false"},
+			wantErr:  utils.NoError(t),
+		},
+		{
+			name:     "all values",
+			values:   map[string]string{"target": "/var", "retries": "5", "mode": "incremental", "dry_run": "true"},
+			wantArgs: []string{"-c", "backup /var --retries=5 $HOME"},
+			wantEnv:  []string{"MODE=incremental", "DRY_RUN=true"},
+			wantErr:  utils.NoError(t),
+		},
+		{
+			name:    "missing required",
+			values:  map[string]string{},
+			wantErr: utils.ErrorTextContains(t, "invalid parameter 'target': a value is required"),
+		},
+		{
+			name:    "undeclared",
+			values:  map[string]string{"target": "/srv", "force": "true"},
+			wantErr: utils.ErrorTextContains(t, "invalid parameter 'force': not declared"),
+		},
+		{
+			name:    "invalid integer",
+			values:  map[string]string{"target": "/srv", "retries": "3; rm -rf /"},
+			wantErr: utils.ErrorTextContains(t, "is not an integer"),
+		},
+		{
+			name:    "invalid boolean",
+			values:  map[string]string{"target": "/srv", "dry_run": "yes"},
+			wantErr: utils.ErrorTextContains(t, "is not true or false"),
+		},
+		{
+			name:    "invalid enum",
+			values:  map[string]string{"target": "/srv", "mode": "partial"},
+			wantErr: utils.ErrorTextContains(t, "is not one of"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := work.NewJob("users/test", "", nil)
+			err := tmpl.Apply(j, tt.values)
+			if !tt.wantErr(err) {
+				t.Fatalf("Template.Apply() error = %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if j.Cmd != tmpl.Cmd || j.Limits != tmpl.Limits || j.Timeout != tmpl.Timeout {
+				t.Errorf("Template.Apply() = %+v, want fixed fields from %+v", j, tmpl)
+			}
+			if !reflect.DeepEqual(j.Args, tt.wantArgs) {
+				t.Errorf("Template.Apply() Args = %q, want %q", j.Args, tt.wantArgs)
+			}
+			if !reflect.DeepEqual(j.Env, tt.wantEnv) {
+				t.Errorf("Template.Apply() Env = %q, want %q", j.Env, tt.wantEnv)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package work
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// collectArtifacts moves every regular file in dir that matches any of patterns into dst,
+// keeping the path relative to dir. Matches that resolve outside of dir, such as through
+// a symlinked parent, are skipped. The relative paths of all collected files are returned.
+func collectArtifacts(dir string, dst string, patterns []string) ([]string, error) {
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve working directory: %w", err)
+	}
+
+	var collected []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(root, pattern))
+		if err != nil {
+			return collected, fmt.Errorf("artifact pattern '%s' is invalid: %w", pattern, err)
+		}
+
+		for _, src := range matches {
+			rel, ok := localPath(root, src)
+			if !ok || slices.Contains(collected, rel) {
+				continue
+			}
+			// never follow a symlink out of the working directory
+			if info, err := os.Lstat(src); err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+
+			target := filepath.Join(dst, rel)
+			if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+				return collected, fmt.Errorf("failed to create artifact directory: %w", err)
+			}
+			if err := os.Rename(src, target); err != nil {
+				return collected, fmt.Errorf("failed to move artifact '%s': %w", rel, err)
+			}
+			collected = append(collected, rel)
+		}
+	}
+
+	return collected, nil
+}
+
+// localPath returns the path of p relative to root, after resolving every symlink
+// in the parent directories of p. False is returned if p is not within root.
+func localPath(root string, p string) (string, bool) {
+	parent, err := filepath.EvalSymlinks(filepath.Dir(p))
+	if err != nil {
+		return "", false
+	}
+
+	rel, err := filepath.Rel(root, filepath.Join(parent, filepath.Base(p)))
+	if err != nil || !filepath.IsLocal(rel) {
+		return "", false
+	}
+	return rel, true
+}
+
+// writeArtifacts writes every regular file under dir to w as a tar archive.
+// An empty archive is written if dir does not exist.
+func writeArtifacts(dir string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && p == dir {
+			return filepath.SkipDir
+		}
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive artifacts: %w", err)
+	}
+
+	return tw.Close()
+}
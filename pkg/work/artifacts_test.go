@@ -0,0 +1,106 @@
+package work
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func Test_collectArtifacts(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+	dir, dst, outside := filepath.Join(tmp, "work"), filepath.Join(tmp, "artifacts"), filepath.Join(tmp, "outside")
+
+	for _, d := range []string{filepath.Join(dir, "out"), outside} {
+		if err := os.MkdirAll(d, 0o700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range []string{filepath.Join(dir, "report.txt"), filepath.Join(dir, "out", "a.log"), filepath.Join(dir, "keep.bin"), filepath.Join(outside, "secret")} {
+		if err := os.WriteFile(f, []byte(filepath.Base(f)), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// escapes through a symlinked file and a symlinked directory
+	if err := os.Symlink(filepath.Join(outside, "secret"), filepath.Join(dir, "secret.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(dir, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := collectArtifacts(dir, dst, []string{"*.txt", "out/*", "link/*", "report.txt", "missing/*"})
+	if err != nil {
+		t.Fatalf("collectArtifacts() error = %v", err)
+	}
+
+	want := []string{"report.txt", filepath.Join("out", "a.log")}
+	if !slices.Equal(got, want) {
+		t.Errorf("collectArtifacts() = %v, want %v", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "out", "a.log")); err != nil {
+		t.Errorf("collectArtifacts() did not move out/a.log: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(outside, "secret")); err != nil {
+		t.Errorf("collectArtifacts() moved a file outside of the working directory: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "keep.bin")); err != nil {
+		t.Errorf("collectArtifacts() moved an unmatched file: %v", err)
+	}
+
+	if _, err := collectArtifacts(dir, dst, []string{"["}); err == nil {
+		t.Errorf("collectArtifacts() should error for a malformed pattern")
+	}
+}
+
+func Test_writeArtifacts(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(dir, "out"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{"report.txt": "done", "out/a.log": "hello"}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := writeArtifacts(dir, &buf); err != nil {
+		t.Fatalf("writeArtifacts() error = %v", err)
+	}
+
+	got := make(map[string]string)
+	tr := tar.NewReader(&buf)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read archive: %v", err)
+		}
+		data, _ := io.ReadAll(tr)
+		got[hdr.Name] = string(data)
+	}
+	if len(got) != len(files) {
+		t.Fatalf("writeArtifacts() archived %v, want %v", got, files)
+	}
+	for name, data := range files {
+		if got[name] != data {
+			t.Errorf("writeArtifacts() %s = %q, want %q", name, got[name], data)
+		}
+	}
+
+	buf.Reset()
+	if err := writeArtifacts(filepath.Join(dir, "missing"), &buf); err != nil {
+		t.Errorf("writeArtifacts() should write an empty archive if there are no artifacts, error = %v", err)
+	}
+}
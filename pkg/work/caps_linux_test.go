@@ -0,0 +1,73 @@
+package work
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestCapabilities_resolve(t *testing.T) {
+	t.Parallel()
+	all := make([]string, 0, len(capabilities))
+	for name := range capabilities {
+		all = append(all, name)
+	}
+	slices.Sort(all)
+
+	tests := []struct {
+		name    string
+		caps    Capabilities
+		want    []string
+		wantErr func(error) bool
+	}{
+		{
+			name:    "default",
+			want:    DefaultCapabilities,
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "drop all",
+			caps:    Capabilities{Drop: []string{"all"}},
+			want:    []string{},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "drop all, then add",
+			caps:    Capabilities{Add: []string{"net_bind_service", "CAP_KILL"}, Drop: []string{"ALL"}},
+			want:    []string{"CAP_KILL", "CAP_NET_BIND_SERVICE"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "add all",
+			caps:    Capabilities{Add: []string{"ALL"}},
+			want:    all,
+			wantErr: utils.NoError(t),
+		},
+		{
+			name: "add and drop",
+			caps: Capabilities{Add: []string{"SYS_ADMIN"}, Drop: []string{"chown", "cap_dac_override", "SETUID", "setgid"}},
+			want: []string{
+				"CAP_AUDIT_WRITE", "CAP_FOWNER", "CAP_FSETID", "CAP_KILL", "CAP_MKNOD", "CAP_NET_BIND_SERVICE",
+				"CAP_NET_RAW", "CAP_SETFCAP", "CAP_SETPCAP", "CAP_SYS_ADMIN", "CAP_SYS_CHROOT",
+			},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "unknown",
+			caps:    Capabilities{Add: []string{"SUPERPOWER"}},
+			wantErr: utils.ErrorTextContains(t, "unknown capability 'CAP_SUPERPOWER'"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.caps.resolve()
+			if !tt.wantErr(err) {
+				t.Fatalf("Capabilities.resolve() error = %v", err)
+			}
+			if err == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("Capabilities.resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
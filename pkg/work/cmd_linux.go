@@ -8,25 +8,34 @@ import (
 	"os"
 	"os/exec"
 	"syscall"
-	"time"
 
 	"github.com/drrev/telehandler/pkg/safe"
 )
 
 const selfExePath = "/proc/self/exe"
 
-// makeCommand creates an [exec.Cmd] to execute the given job.
-func makeCommand(buf *safe.NotifyingBuffer, cgroot string, name string, args ...string) (cmd *exec.Cmd, cancel func()) {
+// makeCommand creates an [exec.Cmd] to execute the command of rc in a sandbox.
+// The returned control socket receives the [setupResult] once the command is started.
+// The child end of the socket, and the [spec.Spec] of rc, are cmd.ExtraFiles, and must
+// be closed by the caller once the command is started.
+func makeCommand(buf *safe.NotifyingBuffer, rc RuntimeConfig) (cmd *exec.Cmd, control *os.File, cancel func(), err error) {
+	specFile, err := newSpecFile(rc.spec())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	control, child, err := newControlSocket()
+	if err != nil {
+		specFile.Close()
+		return nil, nil, nil, err
+	}
+
 	var ctx context.Context
 	ctx, cancel = context.WithCancel(context.Background())
 
-	// inject wrapper args
-	cmdargs := append([]string{"reexec", "--cgroup-root", cgroot, "--", name}, args...)
-
-	cmd = exec.CommandContext(ctx, selfExePath, cmdargs...)
+	cmd = exec.CommandContext(ctx, selfExePath, "reexec")
 
 	// max wait after Cancel() to send SIGKILL
-	cmd.WaitDelay = 5 * time.Second
+	cmd.WaitDelay = StopGracePeriod
 	cmd.Cancel = func() error {
 		if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
 			return err
@@ -36,9 +45,12 @@ func makeCommand(buf *safe.NotifyingBuffer, cgroot string, name string, args ...
 		return nil
 	}
 
-	// mux IO to buf
-	cmd.Stdout = buf
-	cmd.Stderr = buf
+	// mux IO to buf, recording which stream each write came from
+	cmd.Stdout = buf.Writer(safe.Stdout)
+	cmd.Stderr = buf.Writer(safe.Stderr)
+
+	// passed as controlFD and specFD
+	cmd.ExtraFiles = []*os.File{child, specFile}
 
 	// setup Linux specific proc attrs for namespaces, ID mapping, and Pdeathsig
 	cmd.SysProcAttr = &syscall.SysProcAttr{
@@ -47,25 +59,29 @@ func makeCommand(buf *safe.NotifyingBuffer, cgroot string, name string, args ...
 		// see: https://man7.org/linux/man-pages/man2/pr_set_pdeathsig.2const.html
 		Pdeathsig: syscall.SIGTERM,
 		// see: https://man7.org/linux/man-pages/man2/unshare.2.html#DESCRIPTION
-		Cloneflags:   syscall.CLONE_NEWNS | syscall.CLONE_NEWPID | syscall.CLONE_NEWUSER | syscall.CLONE_NEWUTS,
+		Cloneflags:   cloneflags(rc.Namespaces),
 		Unshareflags: syscall.CLONE_NEWNS,
-		// map running UID/GID into root in the new user namespace
-		Credential: &syscall.Credential{Uid: 0, Gid: 0},
+		// map running UID/GID into the user of the job in the new user namespace
+		Credential: &syscall.Credential{Uid: uint32(rc.User), Gid: uint32(rc.User)},
 		UidMappings: []syscall.SysProcIDMap{
 			{
-				ContainerID: 0,
-				HostID:      os.Geteuid(), // use non-root user to run as root
+				ContainerID: rc.User,
+				HostID:      os.Geteuid(), // the host user owns the namespace
 				Size:        1,
 			},
 		},
 		GidMappings: []syscall.SysProcIDMap{
 			{
-				ContainerID: 0,
-				HostID:      os.Getegid(), // use non-root group to run as root
+				ContainerID: rc.User,
+				HostID:      os.Getegid(), // the host group owns the namespace
 				Size:        1,
 			},
 		},
 	}
+	if rc.User != 0 {
+		// keep what reexec needs to set up the sandbox and restrict the command
+		cmd.SysProcAttr.AmbientCaps = reexecCapabilities
+	}
 
 	return
 }
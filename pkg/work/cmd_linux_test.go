@@ -5,10 +5,13 @@ package work
 
 import (
 	"os/exec"
+	"reflect"
 	"slices"
+	"syscall"
 	"testing"
 
 	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/pkg/spec"
 )
 
 func Test_makeCommand(t *testing.T) {
@@ -16,17 +19,65 @@ func Test_makeCommand(t *testing.T) {
 	tmp := t.TempDir()
 
 	buf := safe.NewNotifyingBuffer()
-	cmd, cancel := makeCommand(buf, tmp, "bash", "-c", "echo hello, world!")
+	rc := RuntimeConfig{
+		Args:        []string{"bash", "-c", "echo hello, world!"},
+		Env:         []string{"A=b"},
+		CgroupRoot:  tmp,
+		Limits:      Limits{CPU: 250, Memory: 1 << 30},
+		Namespaces:  DefaultNamespaces,
+		Hostname:    "sandbox",
+		WorkDir:     "/work/a",
+		Rlimits:     []Rlimit{{Type: "RLIMIT_NOFILE", Soft: 64, Hard: 128}},
+		Mounts:      []Mount{{Type: MountTmpfs, Target: "/work/a/scratch", Size: 1 << 20}, {Type: MountBind, Source: "/srv", Target: "/srv", ReadOnly: true}},
+		Overlays:    []string{"/src"},
+		OverlayDir:  "/work/a.overlay",
+		BindSources: []string{"/srv"},
+	}
+	rc.Landlock.ReadOnly = []string{"/usr"}
+	cmd, control, cancel, err := makeCommand(buf, rc)
+	if err != nil {
+		t.Fatalf("makeCommand() error = %v", err)
+	}
+	defer control.Close()
+
+	if len(cmd.ExtraFiles) != 2 {
+		t.Fatalf("makeCommand() cmd.ExtraFiles wanted the control socket and spec, got %v", cmd.ExtraFiles)
+	}
+	defer cmd.ExtraFiles[0].Close()
+	defer cmd.ExtraFiles[1].Close()
 
 	if cmd.Path != selfExePath {
 		t.Errorf("makeCommand() cmd.Path wanted %v, got %v", selfExePath, cmd.Path)
 	}
 
-	args := []string{selfExePath, "reexec", "--cgroup-root", tmp, "--", "bash", "-c", "echo hello, world!"}
+	args := []string{selfExePath, "reexec"}
 	if !slices.Equal(cmd.Args, args) {
 		t.Errorf("makeCommand() cmd.Args wanted %v, got %v", args, cmd.Args)
 	}
 
+	// the spec is read by the reexec process, and converted back
+	s, err := spec.Parse(cmd.ExtraFiles[1])
+	if err != nil {
+		t.Fatalf("spec.Parse() error = %v", err)
+	}
+	got, err := runtimeConfig(s)
+	if err != nil {
+		t.Fatalf("runtimeConfig() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, rc) {
+		t.Errorf("runtimeConfig() = %+v, want %+v", got, rc)
+	}
+
+	// the cgroup namespace is created by the reexec process, along with the command
+	want := uintptr(syscall.CLONE_NEWIPC | syscall.CLONE_NEWNS | syscall.CLONE_NEWPID | syscall.CLONE_NEWUSER | syscall.CLONE_NEWUTS)
+	if cmd.SysProcAttr.Cloneflags != want {
+		t.Errorf("makeCommand() Cloneflags wanted %#x, got %#x", want, cmd.SysProcAttr.Cloneflags)
+	}
+
+	if cmd.SysProcAttr.Credential.Uid != 0 || cmd.SysProcAttr.UidMappings[0].ContainerID != 0 || len(cmd.SysProcAttr.AmbientCaps) > 0 {
+		t.Errorf("makeCommand() wanted root in the user namespace, got %+v", cmd.SysProcAttr)
+	}
+
 	cmd.Path = "/usr/bin/env"
 	cmd.Args = []string{"/usr/bin/env", "sleep", "60"}
 
@@ -37,7 +88,7 @@ func Test_makeCommand(t *testing.T) {
 	// make sure cancel works
 	cancel()
 
-	err := cmd.Wait()
+	err = cmd.Wait()
 	if err == nil {
 		t.Error("cmd.Wait() did not receive expected error")
 	} else if exitErr, ok := err.(*exec.ExitError); !ok {
@@ -48,3 +99,34 @@ func Test_makeCommand(t *testing.T) {
 		}
 	}
 }
+
+func Test_makeCommand_user(t *testing.T) {
+	t.Parallel()
+
+	cmd, control, cancel, err := makeCommand(safe.NewNotifyingBuffer(), RuntimeConfig{Args: []string{"id"}, User: 1000, Capabilities: []string{"CAP_CHOWN", "CAP_KILL"}})
+	if err != nil {
+		t.Fatalf("makeCommand() error = %v", err)
+	}
+	defer cancel()
+	defer control.Close()
+	defer cmd.ExtraFiles[0].Close()
+	defer cmd.ExtraFiles[1].Close()
+
+	attr := cmd.SysProcAttr
+	if attr.Credential.Uid != 1000 || attr.Credential.Gid != 1000 {
+		t.Errorf("makeCommand() Credential wanted 1000:1000, got %v:%v", attr.Credential.Uid, attr.Credential.Gid)
+	}
+	if attr.UidMappings[0].ContainerID != 1000 || attr.GidMappings[0].ContainerID != 1000 {
+		t.Errorf("makeCommand() mappings wanted 1000, got %+v %+v", attr.UidMappings, attr.GidMappings)
+	}
+	if !slices.Equal(attr.AmbientCaps, reexecCapabilities) {
+		t.Errorf("makeCommand() AmbientCaps wanted %v, got %v", reexecCapabilities, attr.AmbientCaps)
+	}
+	s, err := spec.Parse(cmd.ExtraFiles[1])
+	if err != nil {
+		t.Fatalf("spec.Parse() error = %v", err)
+	}
+	if s.Process.User != (spec.User{UID: 1000, GID: 1000}) || !slices.Equal(s.Process.Capabilities.Bounding, []string{"CAP_CHOWN", "CAP_KILL"}) {
+		t.Errorf("makeCommand() spec wanted the user and capabilities, got %+v", s.Process)
+	}
+}
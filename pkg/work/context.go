@@ -1,12 +1,17 @@
 package work
 
 import (
+	"fmt"
 	"log/slog"
+	"os"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/pkg/seccomp"
 )
 
 type execContext struct {
@@ -15,13 +20,55 @@ type execContext struct {
 	buf     *safe.NotifyingBuffer
 	stop    func()
 	stopped atomic.Bool
+	// restart is armed while the Job is [Restarting].
+	restart *time.Timer
+	// deadline is armed while an [Attempt] with a Timeout is running.
+	deadline *time.Timer
+	// due is when deadline fires.
+	due time.Time
+	// remaining is the time left before the Timeout while the Job is [Paused].
+	remaining time.Duration
+	// freeze serializes pausing, resuming, and stopping the Job.
+	freeze sync.Mutex
+	// workDir is the private working directory, if the Executor has a work root.
+	workDir string
+	// stdin is a file passed as standard input to every attempt, if any.
+	stdin string
+	// artifacts is the directory that artifacts are collected into, if the Executor has a work root.
+	artifacts string
+	// seccomp is the profile loaded for the Job when it was started.
+	seccomp seccomp.Profile
+	// capabilities is the resolved capability bounding set of the Job.
+	capabilities []string
+	// mounts of the Job, with their bind sources resolved.
+	mounts []Mount
+	// overlay is the directory of the upper layers of the overlays of the Job, if any.
+	overlay string
+	// layers are the lower layers of the root filesystem of the Job, topmost first, if it has an image.
+	layers []string
+	// rootfs is the directory of the upper layer of the root filesystem of the Job, if it has an image.
+	rootfs string
+	// cwd is the working directory of the image of the Job, if any.
+	cwd string
+	// namespaces are the types of the namespaces created for the Job.
+	namespaces []string
+	// hostname of the Job, if it has a UTS namespace.
+	hostname string
+	// pid of the reexec process of the current [Attempt], which holds the namespaces of the Job.
+	// Zero unless the Job is [Running].
+	pid int
+	// control is the control socket of the reexec process of the current [Attempt],
+	// which starts the processes of [Executor.Exec]. Nil unless the Job is Running.
+	control *os.File
 }
 
 // jobSafe is a thread-safe accessor for Job.
 func (e *execContext) jobSafe() (job Job) {
 	e.m.Lock()
 	defer e.m.Unlock()
-	return e.Job
+	job = e.Job
+	job.Attempts = slices.Clone(job.Attempts)
+	return
 }
 
 // Running is a convenience function to check
@@ -32,6 +79,14 @@ func (e *execContext) Running() bool {
 	return e.Job.Running()
 }
 
+// Active is a convenience function to check
+// if the [Job] is [Queued], [Running], [Paused], or [Restarting].
+func (e *execContext) Active() bool {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.Job.Active()
+}
+
 // buffer is a thread-safe method for getting the [safe.NotifyingBuffer].
 func (e *execContext) buffer() *safe.NotifyingBuffer {
 	e.m.Lock()
@@ -62,16 +117,238 @@ func (e *execContext) interrupt() error {
 	return nil
 }
 
-// exit performs all bookkeeping required when the [Job] exits.
+// cancel performs all bookkeeping required when a [Queued] Job
+// is stopped before its next attempt was started.
+// This operation is thread-safe.
+func (e *execContext) cancel() {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	_ = e.buf.Close()
+	// a restarted Job may have artifacts of earlier attempts
+	e.collect()
+	e.EndTime = time.Now()
+	e.State = Stopped
+	e.stopped.Store(true)
+
+	slog.Info("Job cancelled", slog.Any("job", e.LogValue()))
+}
+
+// begin performs all bookkeeping required when a new [Attempt] is started.
+// The Job stays [Queued] until its sandbox is set up, see [execContext.running].
+// This operation is thread-safe.
+func (e *execContext) begin(stop func()) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	now := time.Now()
+	size, _ := e.buf.Status()
+
+	e.stop = stop
+	if e.StartTime.IsZero() {
+		e.StartTime = now
+	}
+	e.Attempts = append(e.Attempts, Attempt{StartTime: now, OutputStart: int64(size)})
+}
+
+// running marks the Job [Running] once the sandbox of the attempt-th [Attempt] is set up,
+// records the pid and control socket of its reexec process, and arms the Timeout.
+// The control socket is closed once the attempt exits, or right away if it already did,
+// in which case nothing else changes.
+// If the Job was stopped while its sandbox was set up, the attempt is interrupted instead.
+// This operation is thread-safe.
+func (e *execContext) running(attempt int, pid int, control *os.File) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.State != Queued || len(e.Attempts) != attempt {
+		control.Close()
+		return
+	}
+
+	e.pid = pid
+	e.control = control
+	e.State = Running
+	if e.stopped.Load() && e.stop != nil {
+		// stopped while the sandbox was set up, see [execContext.stopStarting]
+		e.stop()
+		e.stop = nil
+		return
+	}
+	if e.Timeout > 0 {
+		e.armDeadline(e.Timeout, e.stop)
+	}
+}
+
+// failSetup records why the sandbox of the attempt-th [Attempt] could not be set up.
+// The Job is not restarted once that attempt exits.
+// This operation is thread-safe.
+func (e *execContext) failSetup(attempt int, reason string) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if attempt > 0 && attempt <= len(e.Attempts) {
+		e.Attempts[attempt-1].SetupError = reason
+	}
+}
+
+// armDeadline calls stop once d elapses. e.m must be held by the caller.
+func (e *execContext) armDeadline(d time.Duration, stop func()) {
+	e.due = time.Now().Add(d)
+	// a timeout fails the attempt, unlike interrupt, so the RestartPolicy still applies
+	e.deadline = time.AfterFunc(d, func() {
+		slog.Info("Job timed out", slog.Any("job", e.jobSafe().LogValue()), slog.Duration("timeout", e.Timeout))
+		stop()
+	})
+}
+
+// paused marks a [Running] Job as [Paused] once its processes are frozen,
+// and suspends its Timeout until it is resumed.
+// Returns false if the Job is no longer running, or already timed out.
+// This operation is thread-safe.
+func (e *execContext) paused() bool {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.State != Running || e.stop == nil {
+		return false
+	}
+	if e.deadline != nil {
+		if !e.deadline.Stop() {
+			return false
+		}
+		e.deadline = nil
+		e.remaining = max(time.Until(e.due), time.Nanosecond)
+	}
+
+	e.State = Paused
+	slog.Info("Job paused", slog.Any("job", e.LogValue()))
+	return true
+}
+
+// resumed marks a [Paused] Job as [Running] once its processes are thawed,
+// and rearms its Timeout with the time that was left.
+// This operation is thread-safe.
+func (e *execContext) resumed() {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.State != Paused {
+		return
+	}
+	if e.remaining > 0 {
+		e.armDeadline(e.remaining, e.stop)
+		e.remaining = 0
+	}
+
+	e.State = Running
+	slog.Info("Job resumed", slog.Any("job", e.LogValue()))
+}
+
+// sandbox returns the control socket of the reexec process of a [Running] Job.
+// This operation is thread-safe.
+func (e *execContext) sandbox() (control *os.File, err error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.State != Running || e.pid == 0 {
+		return nil, invalidJobState(e.State)
+	}
+	return e.control, nil
+}
+
+// cancelRestart stops a [Restarting] Job before the next attempt is started.
+// Returns false if the Job is not Restarting.
 // This operation is thread-safe.
-func (e *execContext) exit(exitCode int) {
+func (e *execContext) cancelRestart() bool {
 	e.m.Lock()
 	defer e.m.Unlock()
 
+	if e.State != Restarting {
+		return false
+	}
+
+	if e.restart != nil {
+		e.restart.Stop()
+		e.restart = nil
+	}
 	_ = e.buf.Close()
+	e.collect()
 	e.EndTime = time.Now()
+	e.State = Stopped
+	e.stopped.Store(true)
+
+	slog.Info("Job terminated", slog.Any("job", e.LogValue()))
+	return true
+}
+
+// stopStarting stops a [Queued] Job that left the queue to be started, but whose sandbox
+// is not set up yet. Its attempt is interrupted once the sandbox reports, see [execContext.running].
+// Returns false if the Job is not Queued.
+// This operation is thread-safe.
+func (e *execContext) stopStarting() bool {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.State != Queued {
+		return false
+	}
+
+	e.stopped.Store(true)
+	slog.Info("Job stopping once started", slog.Any("job", e.LogValue()))
+	return true
+}
+
+// exit performs all bookkeeping required when the current [Attempt] exits.
+// This operation is thread-safe.
+//
+// If the [RestartPolicy] allows another attempt, the Job is left [Restarting]
+// with output open and true is returned.
+func (e *execContext) exit(exitCode int) (restart bool) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.deadline != nil {
+		e.deadline.Stop()
+		e.deadline = nil
+	}
+	e.remaining = 0
+	e.pid = 0
+	if e.control != nil {
+		// the reexec process stops starting processes for Exec
+		e.control.Close()
+		e.control = nil
+	}
+
+	now := time.Now()
+	var reason string
+	if e.seccomp.Kills() && exitCode == signalExitBase+int(syscall.SIGSYS) {
+		// seccomp kills with SIGSYS, which reexec reports as the exit code, but the
+		// exit code alone cannot tell a denial apart from any other SIGSYS, or exit
+		reason = fmt.Sprintf("killed by SIGSYS: a system call was likely denied by seccomp profile '%s'", e.Seccomp)
+	}
+	// retrying cannot fix a sandbox that could not be set up
+	restartable := e.restartable(exitCode)
+	if n := len(e.Attempts); n > 0 {
+		restartable = restartable && e.Attempts[n-1].SetupError == ""
+		size, _ := e.buf.Status()
+		e.Attempts[n-1].EndTime = now
+		e.Attempts[n-1].ExitCode = exitCode
+		e.Attempts[n-1].OutputEnd = int64(size)
+		e.Attempts[n-1].TerminationReason = reason
+	}
 	e.ExitCode = exitCode
 
+	if !e.stopped.Load() && restartable {
+		e.State = Restarting
+		slog.Info("Job restarting", slog.Any("job", e.LogValue()), slog.Int("exit_code", exitCode))
+		return true
+	}
+
+	_ = e.buf.Close()
+	e.collect()
+	e.EndTime = now
+
 	if exitCode == 0 {
 		e.State = Completed
 	} else {
@@ -82,4 +359,30 @@ func (e *execContext) exit(exitCode int) {
 	}
 
 	slog.Info("Job terminated", slog.Any("job", e.LogValue()))
+	return false
+}
+
+// collect moves the artifacts of the terminated [Job] out of its working directory.
+// Files are moved, not copied, so collecting is cheap enough to hold the lock.
+func (e *execContext) collect() {
+	if len(e.Artifacts) == 0 || e.workDir == "" {
+		return
+	}
+
+	files, err := collectArtifacts(e.workDir, e.artifacts, e.Artifacts)
+	if err != nil {
+		slog.Error("Failed to collect artifacts", slog.Any("job", e.LogValue()), slog.Any("error", err))
+	}
+	if len(files) > 0 {
+		slog.Info("Collected artifacts", slog.String("name", e.Name), slog.Any("files", files))
+	}
+}
+
+// cleanup removes the working directory, standard input, artifacts, overlays, and root filesystem of the [Job].
+func (e *execContext) cleanup() {
+	for _, p := range []string{e.workDir, e.stdin, e.artifacts, e.overlay, e.rootfs} {
+		if p != "" {
+			_ = os.RemoveAll(p)
+		}
+	}
 }
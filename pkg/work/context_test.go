@@ -1,11 +1,14 @@
 package work
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"testing"
 
 	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/pkg/seccomp"
 	"github.com/drrev/telehandler/tests/utils"
 )
 
@@ -74,6 +77,34 @@ func Test_execContext_interrupt(t *testing.T) {
 	}
 }
 
+func Test_execContext_cancel(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+	dir, dst := filepath.Join(tmp, "work"), filepath.Join(tmp, "artifacts")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	// written by an earlier attempt of a restarted Job
+	if err := os.WriteFile(filepath.Join(dir, "report.txt"), []byte("attempt 1"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	e := &execContext{
+		Job:       Job{State: Queued, Artifacts: []string{"*.txt"}},
+		buf:       safe.NewNotifyingBuffer(),
+		workDir:   dir,
+		artifacts: dst,
+	}
+	e.cancel()
+
+	if e.State != Stopped {
+		t.Errorf("execContext.cancel() state = %v, want %v", e.State, Stopped)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "report.txt")); err != nil {
+		t.Errorf("execContext.cancel() did not collect artifacts: %v", err)
+	}
+}
+
 func Test_execContext_exit(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -82,6 +113,7 @@ func Test_execContext_exit(t *testing.T) {
 		exitCode int
 		wantJob  Job
 		stopped  bool
+		kills    bool
 	}{
 		{
 			name:     "success",
@@ -102,6 +134,28 @@ func Test_execContext_exit(t *testing.T) {
 			job:      Job{},
 			wantJob:  Job{State: Stopped, ExitCode: -1},
 		},
+		{
+			name:     "seccomp violation",
+			exitCode: 159,
+			kills:    true,
+			job:      Job{Seccomp: "strict", Attempts: []Attempt{{}}},
+			wantJob: Job{Seccomp: "strict", State: Failed, ExitCode: 159, Attempts: []Attempt{{
+				ExitCode:          159,
+				TerminationReason: "killed by SIGSYS: a system call was likely denied by seccomp profile 'strict'",
+			}}},
+		},
+		{
+			name:     "seccomp errno",
+			exitCode: 159,
+			job:      Job{Seccomp: "default", Attempts: []Attempt{{}}},
+			wantJob:  Job{Seccomp: "default", State: Failed, ExitCode: 159, Attempts: []Attempt{{ExitCode: 159}}},
+		},
+		{
+			name:     "setup failed",
+			exitCode: 1,
+			job:      Job{Restart: RestartAlways, Attempts: []Attempt{{SetupError: "no cgroup"}}},
+			wantJob:  Job{Restart: RestartAlways, State: Failed, ExitCode: 1, Attempts: []Attempt{{ExitCode: 1, SetupError: "no cgroup"}}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -112,10 +166,16 @@ func Test_execContext_exit(t *testing.T) {
 				buf:  safe.NewNotifyingBuffer(),
 			}
 			e.stopped.Store(tt.stopped)
+			if tt.kills {
+				e.seccomp = seccomp.Profile{DefaultAction: seccomp.ActKillProcess}
+			}
 			e.exit(tt.exitCode)
 
 			// copy EndTime to prevent valid equality failures
 			tt.wantJob.EndTime = e.EndTime
+			for i := range e.Attempts {
+				tt.wantJob.Attempts[i].EndTime = e.Attempts[i].EndTime
+			}
 
 			if !reflect.DeepEqual(e.Job, tt.wantJob) {
 				t.Errorf("execContext.exit() = %v, wanted = %v", e.Job, tt.wantJob)
@@ -0,0 +1,294 @@
+package work
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/spec"
+	"golang.org/x/sys/unix"
+)
+
+// controlFD is the file descriptor of the control socket in the reexec process.
+// The socket is the first of [exec.Cmd.ExtraFiles], so it follows stdin, stdout, and stderr.
+const controlFD = 3
+
+// specFD is the file descriptor of the [spec.Spec] in the reexec process.
+// The spec is the second of [exec.Cmd.ExtraFiles], following the control socket.
+const specFD = 4
+
+// setupTimeout bounds how long the Executor waits for [Reexec] to set up the sandbox.
+const setupTimeout = 10 * time.Second
+
+// setupResult is sent once by [Reexec] over the control socket, after the sandbox
+// was set up and the command was started, or after either failed.
+type setupResult struct {
+	// PID of the command in the PID namespace of the Job.
+	PID int `json:"pid,omitempty"`
+	// Error describes why the sandbox could not be set up, or the command could not be started.
+	Error string `json:"error,omitempty"`
+}
+
+// newControlSocket creates the control socket between the Executor and [Reexec].
+// The Executor reads from parent, which supports deadlines, and child is passed to the reexec process.
+func newControlSocket() (parent *os.File, child *os.File, err error) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create control socket: %w", err)
+	}
+	// non-blocking, so the Executor end is pollable
+	if err := unix.SetNonblock(fds[0], true); err != nil {
+		unix.Close(fds[0])
+		unix.Close(fds[1])
+		return nil, nil, fmt.Errorf("failed to create control socket: %w", err)
+	}
+	return os.NewFile(uintptr(fds[0]), "control"), os.NewFile(uintptr(fds[1]), "control"), nil
+}
+
+// newSpecFile writes s to a sealed memfd, which is passed to the reexec process.
+// The file is read from the start, and cannot be changed once sealed.
+func newSpecFile(s spec.Spec) (*os.File, error) {
+	fd, err := unix.MemfdCreate("spec", unix.MFD_CLOEXEC|unix.MFD_ALLOW_SEALING)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create runtime spec: %w", err)
+	}
+	f := os.NewFile(uintptr(fd), "spec")
+	if err := json.NewEncoder(f).Encode(s); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to write runtime spec: %w", err)
+	}
+	seals := unix.F_SEAL_SEAL | unix.F_SEAL_SHRINK | unix.F_SEAL_GROW | unix.F_SEAL_WRITE
+	if _, err := unix.FcntlInt(f.Fd(), unix.F_ADD_SEALS, seals); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to seal runtime spec: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// readSpec reads the [spec.Spec] passed to the reexec process, then closes it,
+// so the command does not inherit it.
+func readSpec() (RuntimeConfig, error) {
+	f := os.NewFile(specFD, "spec")
+	defer f.Close()
+
+	s, err := spec.Parse(f)
+	if err != nil {
+		return RuntimeConfig{}, err
+	}
+	return runtimeConfig(s)
+}
+
+// awaitSetup reads the [setupResult] from the control socket of a started reexec process.
+// A failed setup is returned as [ErrSetupFailed], including a reexec process that exited
+// or timed out before reporting a result.
+//
+// The reexec process waits for the control socket to close before exiting after a failure,
+// so the caller must close it once the failure is recorded.
+func awaitSetup(control *os.File) (setupResult, error) {
+	_ = control.SetReadDeadline(time.Now().Add(setupTimeout))
+
+	var res setupResult
+	err := json.NewDecoder(control).Decode(&res)
+	switch {
+	case errors.Is(err, io.EOF):
+		return res, setupFailed("sandbox exited before it was set up")
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		return res, setupFailed(fmt.Sprintf("sandbox was not set up within %v", setupTimeout))
+	case err != nil:
+		return res, setupFailed(fmt.Sprintf("malformed setup result: %v", err))
+	case res.Error != "":
+		return res, setupFailed(res.Error)
+	}
+	return res, nil
+}
+
+// reportSetup sends res over the control socket of the reexec process.
+// If res is a failure, reportSetup blocks until the Executor closes the socket,
+// so the failure is recorded before the reexec process exits.
+//
+// If the Executor cannot be reached, a failure is written to stderr instead.
+func reportSetup(control *os.File, res setupResult) {
+	err := json.NewEncoder(control).Encode(res)
+	if res.Error == "" {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, res.Error)
+		return
+	}
+	_, _ = io.Copy(io.Discard, control)
+}
+
+// execRequest is sent by [Executor.Exec] to the reexec process of a running [Job]
+// to start an additional process in its sandbox. The standard input, output, and error
+// of the process are passed along with it.
+//
+// Requests are sent over an exec socket, one per process, which is itself passed over
+// the control socket. The reexec process replies with [execResult]s on the same socket.
+type execRequest struct {
+	// Args is the command, and its args.
+	Args []string `json:"args"`
+	// Env lists the KEY=VALUE environment variables added to those of the command of the Job.
+	Env []string `json:"env,omitempty"`
+	// TTY makes the standard input of the process its controlling terminal.
+	TTY bool `json:"tty,omitempty"`
+}
+
+// execResult is sent by the reexec process once the process of an [execRequest]
+// is started, or failed to start, then again once it exited.
+type execResult struct {
+	// Error describes why the process could not be started.
+	Error string `json:"error,omitempty"`
+	// Exited is set once the process exited with ExitCode, or 128 plus the signal number
+	// if it was killed by a signal.
+	Exited   bool `json:"exited,omitempty"`
+	ExitCode int  `json:"exit_code,omitempty"`
+}
+
+// maxExecRequest bounds the size of an [execRequest], which is a single message.
+const maxExecRequest = 1 << 20
+
+// requestExec sends req, with files as the standard input, output, and error of the process,
+// to the reexec process holding the other end of control, then waits for the process to start.
+// The returned exec socket receives the [execResult] of the process once it exits,
+// and the process is killed if the socket is shut down for writing.
+func requestExec(control *os.File, req execRequest, files []*os.File) (*net.UnixConn, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxExecRequest {
+		return nil, invalidJob("command, args, and env are too long")
+	}
+
+	// a message based socket, so each request and result is read whole
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_SEQPACKET|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exec socket: %w", err)
+	}
+	local, peer := os.NewFile(uintptr(fds[0]), "exec"), os.NewFile(uintptr(fds[1]), "exec")
+	defer local.Close()
+	defer peer.Close()
+	fc, err := net.FileConn(local)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exec socket: %w", err)
+	}
+	conn := fc.(*net.UnixConn)
+
+	// the control socket is a stream, so it only passes the exec socket, not the request
+	if err := sendFiles(control, []byte{0}, peer); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to send exec request: %w", err)
+	}
+	if _, _, err := conn.WriteMsgUnix(data, unixRights(files), nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to send exec request: %w", err)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(setupTimeout))
+	res, err := readExecResult(conn)
+	_ = conn.SetReadDeadline(time.Time{})
+	switch {
+	case errors.Is(err, io.EOF):
+		err = errors.New("sandbox exited before the process was started")
+	case err != nil:
+		err = fmt.Errorf("failed to start process: %w", err)
+	case res.Error != "":
+		err = fmt.Errorf("failed to start process: %s", res.Error)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// unixRights encodes files as a socket control message, so they can be sent with a message.
+func unixRights(files []*os.File) []byte {
+	fds := make([]int, 0, len(files))
+	for _, f := range files {
+		fds = append(fds, int(f.Fd()))
+	}
+	return unix.UnixRights(fds...)
+}
+
+// sendFiles sends data, with files attached, over the non-blocking socket f.
+func sendFiles(f *os.File, data []byte, files ...*os.File) error {
+	raw, err := f.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var sendErr error
+	if err := raw.Write(func(fd uintptr) bool {
+		sendErr = unix.Sendmsg(int(fd), data, unixRights(files), nil, 0)
+		return !errors.Is(sendErr, unix.EAGAIN)
+	}); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// readExecResult reads the next [execResult] from the exec socket conn.
+func readExecResult(conn *net.UnixConn) (execResult, error) {
+	var res execResult
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return res, err
+	}
+	if err := json.Unmarshal(buf[:n], &res); err != nil {
+		return res, fmt.Errorf("malformed exec result: %w", err)
+	}
+	return res, nil
+}
+
+// sendExecResult sends res over the exec socket conn.
+func sendExecResult(conn *net.UnixConn, res execResult) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Write(data)
+	return err
+}
+
+// receiveFiles reads a message of at most size bytes from conn, along with at most n files
+// attached to it. Files beyond n are closed. The files are close-on-exec.
+func receiveFiles(conn *net.UnixConn, size, n int) (data []byte, files []*os.File, err error) {
+	buf, oob := make([]byte, size), make([]byte, unix.CmsgSpace(n*4))
+	m, oobn, flags, _, err := conn.ReadMsgUnix(buf, oob)
+	if err != nil {
+		return nil, nil, err
+	}
+	msgs, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, msg := range msgs {
+		fds, err := unix.ParseUnixRights(&msg)
+		if err != nil {
+			continue
+		}
+		for _, fd := range fds {
+			files = append(files, os.NewFile(uintptr(fd), "received"))
+		}
+	}
+	if flags&unix.MSG_TRUNC != 0 {
+		for _, f := range files {
+			f.Close()
+		}
+		return nil, nil, errors.New("message too long")
+	}
+	if m == 0 && len(files) == 0 {
+		return nil, nil, io.EOF
+	}
+	return buf[:m], files, nil
+}
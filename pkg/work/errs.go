@@ -39,3 +39,60 @@ type ErrJobNotFound struct {
 func (e *ErrJobNotFound) Error() string {
 	return fmt.Sprintf("no job found with name='%v'", e.name)
 }
+
+func invalidJobID(id string) *ErrInvalidJobID {
+	return &ErrInvalidJobID{id}
+}
+
+// ErrInvalidJobID is returned if a client-chosen Job ID is malformed.
+type ErrInvalidJobID struct {
+	id string
+}
+
+// Error implements error.
+func (e *ErrInvalidJobID) Error() string {
+	return fmt.Sprintf("job id '%s' is invalid: must be 1-63 lowercase letters, digits, or '-', starting with a letter and not ending with '-'", e.id)
+}
+
+func invalidJob(reason string) *ErrInvalidJob {
+	return &ErrInvalidJob{reason}
+}
+
+// ErrInvalidJob is returned if the runtime configuration of a Job is malformed.
+type ErrInvalidJob struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidJob) Error() string {
+	return fmt.Sprintf("invalid job: %s", e.reason)
+}
+
+func invalidSearch(reason string) *ErrInvalidSearch {
+	return &ErrInvalidSearch{reason}
+}
+
+// ErrInvalidSearch is returned if a [Search] of Job output is malformed.
+type ErrInvalidSearch struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidSearch) Error() string {
+	return fmt.Sprintf("invalid search: %s", e.reason)
+}
+
+func setupFailed(reason string) *ErrSetupFailed {
+	return &ErrSetupFailed{reason}
+}
+
+// ErrSetupFailed is returned if the sandbox of a Job could not be set up,
+// or its command could not be started. The command never ran.
+type ErrSetupFailed struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrSetupFailed) Error() string {
+	return fmt.Sprintf("failed to set up job: %s", e.reason)
+}
@@ -0,0 +1,200 @@
+package work
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// ExecSpec describes an additional process to run in the sandbox of a [Running] [Job].
+type ExecSpec struct {
+	// Cmd path to an executable to run.
+	Cmd string
+	// Args passed to the process.
+	Args []string
+	// Env lists KEY=VALUE environment variables added to those of the command of the Job.
+	Env []string
+	// TTY runs the process with a pseudo-terminal as its controlling terminal.
+	TTY bool
+	// Rows and Cols are the initial size of the pseudo-terminal, if TTY is set.
+	Rows, Cols uint16
+}
+
+// Process is an additional process started in the sandbox of a [Job] with [Executor.Exec].
+type Process struct {
+	// conn is the exec socket of the process, see [requestExec].
+	conn *net.UnixConn
+	pty  *os.File
+	// Stdin is the standard input of the process, see [Process.CloseStdin].
+	Stdin io.Writer
+	stdin io.Closer
+	// Stdout is the standard output of the process.
+	// With a TTY, Stdout holds all output of the terminal.
+	Stdout io.ReadCloser
+	// Stderr is the standard error of the process. Nil with a TTY.
+	Stderr io.ReadCloser
+	done   chan struct{}
+	code   int
+}
+
+// Resize changes the size of the pseudo-terminal of the process.
+// This is a no-op if the process has no TTY.
+func (p *Process) Resize(rows, cols uint16) error {
+	if p.pty == nil {
+		return nil
+	}
+	return unix.IoctlSetWinsize(int(p.pty.Fd()), unix.TIOCSWINSZ, &unix.Winsize{Row: rows, Col: cols})
+}
+
+// CloseStdin signals the end of standard input.
+// With a TTY, the end-of-file character is written instead, since the terminal stays open.
+func (p *Process) CloseStdin() error {
+	if p.pty != nil {
+		_, err := p.pty.Write([]byte{4})
+		return err
+	}
+	return p.stdin.Close()
+}
+
+// Kill the process. Any processes it started keep running until the Job terminates.
+func (p *Process) Kill() {
+	// the reexec process kills it once the exec socket is shut down
+	_ = p.conn.CloseWrite()
+}
+
+// Wait blocks until the process exits, then returns its exit code.
+// Output may still be buffered in Stdout and Stderr once Wait returns.
+func (p *Process) Wait() int {
+	<-p.done
+	return p.code
+}
+
+// Exec starts an additional process in the sandbox of the [Running] [Job] with the given name.
+// The process is started by the reexec process of the current [Attempt], like the command of the Job,
+// so it is in all of the namespaces, and the cgroup, of the Job, and runs with the same user, rlimits,
+// capability bounding set, no_new_privs, Landlock rules, and seccomp profile. The command is looked up,
+// and runs, within the root filesystem of the Job, in the same working directory as the command of the Job,
+// and its environment adds spec.Env to that of the command of the Job.
+//
+// An error is returned if the Job does not exist, [ErrInvalidJobState] if it is not running,
+// or [ErrInvalidJob] if spec is malformed.
+func (m *Executor) Exec(name string, spec ExecSpec) (*Process, error) {
+	if spec.Cmd == "" {
+		return nil, invalidJob("command must not be empty")
+	}
+	if err := validateEnv(spec.Env); err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	ec, err := m.lookupContext(name)
+	m.mu.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	control, err := ec.sandbox()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Process{done: make(chan struct{})}
+	// the ends of each pipe held by the process, closed once it is started
+	var child, stdio []*os.File
+	defer func() {
+		for _, f := range child {
+			f.Close()
+		}
+	}()
+
+	if spec.TTY {
+		pty, tty, err := openPTY()
+		if err != nil {
+			return nil, err
+		}
+		child, stdio = append(child, tty), []*os.File{tty, tty, tty}
+		p.pty, p.Stdin, p.stdin, p.Stdout = pty, pty, pty, pty
+		if err := p.Resize(spec.Rows, spec.Cols); err != nil {
+			pty.Close()
+			return nil, fmt.Errorf("failed to size terminal: %w", err)
+		}
+	} else {
+		pipes := make([]*os.File, 0, 6)
+		for range 3 {
+			r, w, err := os.Pipe()
+			if err != nil {
+				for _, f := range pipes {
+					f.Close()
+				}
+				return nil, fmt.Errorf("failed to create pipe: %w", err)
+			}
+			pipes = append(pipes, r, w)
+		}
+		child = append(child, pipes[0], pipes[3], pipes[5])
+		stdio = child
+		p.Stdin, p.stdin, p.Stdout, p.Stderr = pipes[1], pipes[1], pipes[2], pipes[4]
+	}
+
+	req := execRequest{Args: append([]string{spec.Cmd}, spec.Args...), Env: spec.Env, TTY: spec.TTY}
+	conn, err := requestExec(control, req, stdio)
+	if err != nil {
+		p.close()
+		return nil, err
+	}
+	p.conn = conn
+	go p.wait()
+
+	return p, nil
+}
+
+// close releases the ends of all pipes held by the Executor.
+func (p *Process) close() {
+	for _, c := range []io.Closer{p.stdin, p.Stdout, p.Stderr} {
+		if c != nil {
+			c.Close()
+		}
+	}
+}
+
+// wait waits for the exit code of the process to be reported over its exec socket.
+// If the reexec process exits first, the process was killed along with the Job,
+// and its exit code is 255.
+func (p *Process) wait() {
+	defer close(p.done)
+	defer p.conn.Close()
+
+	p.code = 255
+	if res, err := readExecResult(p.conn); err == nil && res.Exited {
+		p.code = res.ExitCode
+	}
+}
+
+// openPTY opens a new pseudo-terminal, returning the controlling end and the terminal.
+func openPTY() (pty *os.File, tty *os.File, err error) {
+	pty, err = os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open pseudo-terminal: %w", err)
+	}
+
+	n, err := unix.IoctlGetInt(int(pty.Fd()), unix.TIOCGPTN)
+	if err == nil {
+		err = unix.IoctlSetPointerInt(int(pty.Fd()), unix.TIOCSPTLCK, 0)
+	}
+	if err != nil {
+		pty.Close()
+		return nil, nil, fmt.Errorf("failed to unlock pseudo-terminal: %w", err)
+	}
+
+	tty, err = os.OpenFile("/dev/pts/"+strconv.Itoa(n), os.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		pty.Close()
+		return nil, nil, fmt.Errorf("failed to open terminal: %w", err)
+	}
+	return pty, tty, nil
+}
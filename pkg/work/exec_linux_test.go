@@ -0,0 +1,169 @@
+package work
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// the test binary is the reexec command of the Executors it creates, see [makeCommand]
+	if len(os.Args) > 1 && os.Args[1] == "reexec" {
+		code, err := Reexec(context.Background())
+		if err != nil {
+			os.Exit(CannotExecute)
+		}
+		os.Exit(code)
+	}
+	os.Exit(m.Run())
+}
+
+// cgroupRoot creates a cgroup for the Jobs of a test, which is removed once it is done.
+// The test is skipped unless it runs as root with a cgroup2 hierarchy.
+func cgroupRoot(t *testing.T) string {
+	t.Helper()
+	if os.Geteuid() != 0 {
+		t.Skip("sandboxes can only be created as root")
+	}
+	mounts, err := os.ReadFile("/proc/self/mounts")
+	if err != nil {
+		t.Skip(err)
+	}
+	for _, line := range strings.Split(string(mounts), "\n") {
+		if fields := strings.Fields(line); len(fields) > 2 && fields[2] == "cgroup2" {
+			dir, err := os.MkdirTemp(fields[1], "telehandler-test-")
+			if err != nil {
+				continue
+			}
+			t.Cleanup(func() { os.Remove(dir) })
+			// the cgroup of every Job is constrained by these controllers
+			if err := os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte("+cpu +memory +io"), 0o644); err != nil {
+				t.Skipf("cannot enable cgroup controllers: %v", err)
+			}
+			return dir
+		}
+	}
+	t.Skip("no cgroup2 hierarchy is mounted")
+	return ""
+}
+
+func TestExecutor_Exec(t *testing.T) {
+	t.Parallel()
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			fn(0)
+			return nil
+		},
+	}
+
+	if _, err := m.Start(Job{Name: "a", UID: "a", Cmd: "true"}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+
+	var notFound *ErrJobNotFound
+	if _, err := m.Exec("missing", ExecSpec{Cmd: "sh"}); !errors.As(err, &notFound) {
+		t.Errorf("Executor.Exec() error = %v, want ErrJobNotFound", err)
+	}
+	var state *ErrInvalidJobState
+	if _, err := m.Exec("a", ExecSpec{Cmd: "sh"}); !errors.As(err, &state) {
+		t.Errorf("Executor.Exec() error = %v, want ErrInvalidJobState for a completed job", err)
+	}
+	var invalid *ErrInvalidJob
+	if _, err := m.Exec("a", ExecSpec{}); !errors.As(err, &invalid) {
+		t.Errorf("Executor.Exec() error = %v, want ErrInvalidJob for an empty command", err)
+	}
+	if _, err := m.Exec("a", ExecSpec{Cmd: "sh", Env: []string{"A"}}); !errors.As(err, &invalid) {
+		t.Errorf("Executor.Exec() error = %v, want ErrInvalidJob for malformed env", err)
+	}
+}
+
+func TestExecutor_ExecSandboxed(t *testing.T) {
+	t.Parallel()
+	m := NewExecutor(Settings{CgroupRoot: cgroupRoot(t)})
+
+	job := Job{Name: "sandboxed", UID: "sandboxed", Cmd: "sleep", Args: []string{"60"}, RunAsUser: 1000, Seccomp: "default"}
+	if _, err := m.Start(job); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+	defer func() {
+		_ = m.Stop(job.Name)
+		_ = m.Wait(job.Name)
+	}()
+
+	p, err := m.Exec(job.Name, ExecSpec{Cmd: "cat", Args: []string{"/proc/self/status"}})
+	if err != nil {
+		t.Fatalf("Executor.Exec() error = %v", err)
+	}
+	p.CloseStdin()
+	out, _ := io.ReadAll(p.Stdout)
+	if code := p.Wait(); code != 0 {
+		t.Fatalf("Process.Wait() = %v, output %q", code, out)
+	}
+
+	// the process runs as the user of the Job, with its restrictions
+	var got []string
+	for sc := bufio.NewScanner(strings.NewReader(string(out))); sc.Scan(); {
+		key, value, _ := strings.Cut(sc.Text(), ":")
+		switch key {
+		case "Uid", "Gid", "NoNewPrivs", "Seccomp", "CapEff":
+			got = append(got, key+"="+strings.Join(strings.Fields(value), " "))
+		}
+	}
+	want := []string{"Uid=1000 1000 1000 1000", "Gid=1000 1000 1000 1000", "CapEff=0000000000000000", "NoNewPrivs=1", "Seccomp=2"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Executor.Exec() process status = %v, want %v", got, want)
+	}
+
+	// a killed process reports the signal
+	p, err = m.Exec(job.Name, ExecSpec{Cmd: "sleep", Args: []string{"60"}})
+	if err != nil {
+		t.Fatalf("Executor.Exec() error = %v", err)
+	}
+	p.Kill()
+	if code := p.Wait(); code != signalExitBase+9 {
+		t.Errorf("Process.Wait() = %v, want %v", code, signalExitBase+9)
+	}
+
+	if _, err := m.Exec(job.Name, ExecSpec{Cmd: "missing-command"}); err == nil {
+		t.Errorf("Executor.Exec() should error on a missing command")
+	}
+}
+
+func Test_openPTY(t *testing.T) {
+	t.Parallel()
+	if _, err := os.Stat("/dev/ptmx"); err != nil {
+		t.Skip("pseudo-terminals are not available")
+	}
+
+	pty, tty, err := openPTY()
+	if err != nil {
+		t.Fatalf("openPTY() error = %v", err)
+	}
+	defer pty.Close()
+	defer tty.Close()
+
+	p := &Process{pty: pty}
+	if err := p.Resize(24, 80); err != nil {
+		t.Errorf("Process.Resize() error = %v", err)
+	}
+
+	if _, err := pty.Write([]byte("hi\n")); err != nil {
+		t.Fatalf("failed to write to pseudo-terminal: %v", err)
+	}
+	buf := make([]byte, 16)
+	n, err := tty.Read(buf)
+	if err != nil || string(buf[:n]) != "hi\n" {
+		t.Errorf("terminal read = %q, %v, want %q", buf[:n], err, "hi\n")
+	}
+}
@@ -1,68 +1,303 @@
 package work
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"log/slog"
+	"maps"
+	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
+	"syscall"
 	"time"
 
+	"github.com/drrev/telehandler/pkg/cgroup2"
+	"github.com/drrev/telehandler/pkg/image"
+	"github.com/drrev/telehandler/pkg/labels"
+	"github.com/drrev/telehandler/pkg/landlock"
 	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/pkg/seccomp"
+	"github.com/drrev/telehandler/pkg/spec"
+	"github.com/google/uuid"
 )
 
 // commandStarter starts and waits for execution of commands,
 // then calls done with the process exit_code.
+// If the command cannot be started, an error is returned and done is never called.
 type commandStarter func(c *exec.Cmd, done func(exitCode int)) error
 
 // Executor is a thread-safe [Job] manager.
 // Each Job runs in a separate subprocess.
 // All Jobs are resource limited using cgroup v2 and
-// placed in separate PID, User, and Network Linux namespaces.
+// placed in separate PID, mount, and user Linux namespaces,
+// along with the cgroup, IPC, network, UTS, and time namespaces
+// of [Settings.Namespaces], or Job.Namespaces.
 //
 // Despite namespace isolation, this does not create a full
 // sandbox. Mounts are not fully isolated from the host and Jobs
 // can still interact with any mounted partitions on the host system.
 //
-// Network is fully isolated. Jobs have no non-loopback network interfaces,
-// thus no network connectivity. The hostname is forced to "sandbox".
+// Jobs share the network of the host, unless they have a network namespace,
+// which only has a loopback interface that is down, thus no network connectivity.
+// The hostname is set to Job.Hostname in the UTS namespace.
+//
+// System calls are filtered with a seccomp profile, see Job.Seccomp.
+// The capability bounding set is restricted, see Job.Capabilities, and no_new_privs
+// is set, so setuid binaries do not gain privileges. Host paths can be restricted
+// with Landlock, see Job.Landlock. Host directories can be made writable without
+// changing the host, see Job.Overlays.
+//
+// Jobs submitted while the Executor is at [Capacity] are [Queued] and
+// started as soon as capacity frees up.
 //
 // See [NewExecutor].
 type Executor struct {
-	mu       sync.RWMutex
-	cgroot   string
-	contexts map[string]*execContext
-	startCmd commandStarter
+	mu         sync.RWMutex
+	cgroot     string
+	workRoot   string
+	seccompDir string
+	// bindSources are the host paths, with symlinks resolved, that Jobs may bind.
+	bindSources []string
+	images      *image.Store
+	namespaces  []string
+	droppable   []string
+	contexts    map[string]*execContext
+	startCmd    commandStarter
+
+	// schedMu guards sched. It is never held while a command is started,
+	// since commands may exit, and release capacity, synchronously.
+	schedMu  sync.Mutex
+	capacity Capacity
+	sched    *scheduler
+
+	restartBackoff time.Duration
+
+	changed safe.Notifier
+}
+
+// DefaultRestartBackoff is the delay before the first restart of a [Job]
+// if [Settings.RestartBackoff] is not set.
+const DefaultRestartBackoff = time.Second
+
+// MaxRestartBackoff caps the delay between restarts of a [Job].
+const MaxRestartBackoff = 5 * time.Minute
+
+// Settings configures an [Executor].
+type Settings struct {
+	// CgroupRoot is the path to the cgroup v2 mount under
+	// which a cgroup is created for each Job.
+	CgroupRoot string
+	// WorkRoot is the directory under which a private working directory
+	// is created for each Job. If empty, Jobs run in the working directory
+	// of the server and cannot accept [Inputs].
+	WorkRoot string
+	// Capacity limits the resources used by running Jobs.
+	// The zero value does not limit Jobs.
+	Capacity Capacity
+	// RestartBackoff is the delay before a Job is restarted for the first time.
+	// The delay doubles with each attempt, up to [MaxRestartBackoff].
+	// Defaults to [DefaultRestartBackoff].
+	RestartBackoff time.Duration
+	// SeccompDir is a directory of JSON seccomp profiles supplied by the administrator.
+	// Jobs select the profile <name>.json by name, in addition to the built-in profiles.
+	SeccompDir string
+	// BindSources are the host paths, and everything beneath them, that Jobs may bind
+	// into their sandbox with a [Mount]. Jobs cannot bind any host path if empty.
+	BindSources []string
+	// Images is the store of the images that Jobs may run in.
+	// Jobs cannot have an image if nil.
+	Images *image.Store
+	// Namespaces lists the types of the namespaces created for Jobs that do not list their own.
+	// Must include [RequiredNamespaces], see [ValidateNamespaces]. Defaults to [DefaultNamespaces].
+	Namespaces []string
+	// DroppableNamespaces lists the namespaces of Namespaces that Jobs listing their own may leave out.
+	// Jobs may add any namespace, but not leave out any other, so they cannot weaken their isolation.
+	DroppableNamespaces []string
 }
 
 // NewExecutor creates an initialized [Executor] ready for use.
-func NewExecutor(cgroupRoot string) *Executor {
+func NewExecutor(s Settings) *Executor {
+	if s.RestartBackoff <= 0 {
+		s.RestartBackoff = DefaultRestartBackoff
+	}
+	if len(s.Namespaces) == 0 {
+		s.Namespaces = DefaultNamespaces
+	}
+
 	return &Executor{
-		mu:       sync.RWMutex{},
-		cgroot:   cgroupRoot,
-		contexts: make(map[string]*execContext),
-		startCmd: startCmd,
+		mu:             sync.RWMutex{},
+		cgroot:         s.CgroupRoot,
+		workRoot:       s.WorkRoot,
+		seccompDir:     s.SeccompDir,
+		bindSources:    resolveBindSources(s.BindSources),
+		images:         s.Images,
+		namespaces:     slices.Clone(s.Namespaces),
+		droppable:      slices.Clone(s.DroppableNamespaces),
+		contexts:       make(map[string]*execContext),
+		startCmd:       startCmd,
+		capacity:       s.Capacity,
+		restartBackoff: s.RestartBackoff,
 	}
 }
 
 // Start the given [Job]. An error is returned if the Job could not be started.
 //
-// [ErrInvalidState] is returned if the Job already exists with a non-running status.
+// [ErrInvalidState] is returned if the Job already exists with a non-active status.
+// [ErrExceedsCapacity] is returned if the Job could never fit within the [Capacity].
+// [ErrInvalidJob] is returned if the Job env, limits, timeout, inputs, or artifacts are malformed,
+// its seccomp profile does not exist or is malformed, it names an unknown capability,
+// a mount is malformed or binds a source that is not allowed, an overlay is not
+// an existing directory outside of the work root, its image is not found, its namespaces
+// are not supported or leave out one that is not droppable, see [Settings.DroppableNamespaces],
+// or it has a hostname without a UTS namespace.
+// [labels.ErrInvalid] is returned if the Job labels or annotations are malformed.
+// [ErrSetupFailed] is returned if the sandbox of the Job could not be set up,
+// including if the Job has Landlock rules that the kernel cannot enforce.
+//
+// A started Job is only [Running] once its sandbox is set up.
+//
+// Calling Start on a Job that is already queued or running is a no-op.
+//
+// Start takes ownership of the Job [Inputs], which are moved into the
+// private working directory of the Job, or removed if no Job is started.
 //
-// Calling Start on a Job that is already running is a no-op.
+// If the Executor is at capacity, the Job is [Queued] and started
+// once enough capacity is released by other Jobs.
+//
+// If the Job has a [RestartPolicy], the subprocess is restarted with
+// exponential backoff each time it exits, until the policy is satisfied.
+// Every restart is queued like a new Job, but all attempts share the same
+// name and output.
 //
 // This operation is stateful. If this call is successful, a copy of Job is
 // maintained internally. Use [Executor.Find] to lookup any existing Jobs for the
 // latest state.
 func (m *Executor) Start(j Job) (Job, error) {
+	// setting up a sandbox may take up to setupTimeout, so other Jobs
+	// are not locked out while the admitted Jobs are launched
 	m.mu.Lock()
-	defer m.mu.Unlock()
+	ec, admitted, job, err := m.add(j)
+	m.mu.Unlock()
+
+	if ec == nil {
+		return job, err
+	}
 
-	ec, err := m.lookupContext(j.Name)
+	var startErr error
+	for _, t := range admitted {
+		if err := m.launch(t.ec); err != nil {
+			if t.ec != ec {
+				slog.Error("Failed to start queued job", slog.Any("job", t.ec.jobSafe().LogValue()), slog.Any("error", err))
+				continue
+			}
+			startErr = err
+		}
+	}
+
+	if startErr == nil && ec.jobSafe().State == Queued {
+		slog.Info("Job queued", slog.Any("job", ec.jobSafe().LogValue()))
+	}
+	m.changed.Broadcast()
+
+	return ec.jobSafe(), startErr
+}
+
+// add validates a new Job, then adds it to the Executor and submits it to the scheduler.
+// The Jobs admitted by the scheduler must be launched by the caller, after releasing m.mu.
+// If the Job is not added, a nil execContext is returned along with the Job to return from [Executor.Start],
+// and its Inputs are removed.
+// m.mu must be held by the caller.
+func (m *Executor) add(j Job) (ec *execContext, admitted []ticket, _ Job, err error) {
+	// the Inputs are moved into the working directory of an added Job, or removed
+	defer func() {
+		if ec == nil {
+			j.Inputs.remove()
+		}
+	}()
+
+	ec, err = m.lookupContext(j.Name)
 	if err == nil {
-		if !ec.Running() {
-			return ec.jobSafe(), invalidJobState(ec.State)
+		if !ec.Active() {
+			return nil, nil, ec.jobSafe(), invalidJobState(ec.State)
 		}
-		return ec.jobSafe(), nil
+		return nil, nil, ec.jobSafe(), nil
+	}
+
+	if err := j.validate(); err != nil {
+		return nil, nil, j, err
+	}
+	if !j.Inputs.empty() && m.workRoot == "" {
+		return nil, nil, j, invalidJob("inputs require a work root")
+	}
+	if len(j.Artifacts) > 0 && m.workRoot == "" {
+		return nil, nil, j, invalidJob("artifacts require a work root")
+	}
+	if err := m.checkOverlays(j.Overlays); err != nil {
+		return nil, nil, j, err
+	}
+	if j.Seccomp == "" {
+		j.Seccomp = seccomp.Default
+	}
+	profile, err := m.seccompProfile(j.Seccomp)
+	if err != nil {
+		return nil, nil, j, err
+	}
+	caps, err := j.Capabilities.resolve()
+	if err != nil {
+		return nil, nil, j, err
+	}
+	mounts, err := m.resolveMounts(j.Mounts)
+	if err != nil {
+		return nil, nil, j, err
+	}
+	img, layers, err := m.resolveImage(j)
+	if err != nil {
+		return nil, nil, j, err
+	}
+	namespaces := j.Namespaces
+	if namespaces == nil {
+		namespaces = m.namespaces
+	}
+	if err := checkDropped(namespaces, m.namespaces, m.droppable); err != nil {
+		return nil, nil, j, err
+	}
+	hasUTS := slices.Contains(namespaces, spec.NamespaceUTS)
+	if j.Hostname != "" && !hasUTS {
+		return nil, nil, j, invalidJob("hostname requires a uts namespace")
+	}
+	if !j.Landlock.Empty() {
+		if _, err := landlock.ABI(); err != nil {
+			return nil, nil, j, setupFailed(err.Error())
+		}
+	}
+	j.Args = slices.Clone(j.Args)
+	j.Env = slices.Clone(j.Env)
+	j.Artifacts = slices.Clone(j.Artifacts)
+	j.Capabilities.Add = slices.Clone(j.Capabilities.Add)
+	j.Capabilities.Drop = slices.Clone(j.Capabilities.Drop)
+	j.Landlock.ReadOnly = slices.Clone(j.Landlock.ReadOnly)
+	j.Landlock.ReadWrite = slices.Clone(j.Landlock.ReadWrite)
+	j.Rlimits = slices.Clone(j.Rlimits)
+	j.Mounts = slices.Clone(j.Mounts)
+	j.Overlays = slices.Clone(j.Overlays)
+	j.Namespaces = slices.Clone(j.Namespaces)
+	j.Labels = maps.Clone(j.Labels)
+	j.Annotations = maps.Clone(j.Annotations)
+	if j.Image != "" {
+		if j.Cmd != "" {
+			j.Args = append([]string{j.Cmd}, j.Args...)
+		}
+		j.Cmd, j.Args, _ = img.Command(j.Args)
+		j.Env = append(slices.Clone(img.Env), j.Env...)
+	}
+	if j.UID == "" {
+		j.UID = uuid.New().String()
 	}
 
 	ec = &execContext{
@@ -70,22 +305,487 @@ func (m *Executor) Start(j Job) (Job, error) {
 		m:   sync.Mutex{},
 		buf: safe.NewNotifyingBuffer(),
 	}
+	ec.State = Queued
+	ec.Inputs = Inputs{}
+	ec.seccomp = profile
+	ec.capabilities = caps
+	ec.mounts = mounts
+	ec.layers = layers
+	ec.cwd = img.WorkingDir
+	if j.Cwd != "" {
+		ec.cwd = j.Cwd
+	}
+	ec.namespaces = slices.Clone(namespaces)
+	if hasUTS {
+		ec.hostname = j.Hostname
+		if ec.hostname == "" {
+			ec.hostname = DefaultHostname
+		}
+	}
+
+	if err := m.prepare(ec, j.Inputs); err != nil {
+		return nil, nil, ec.jobSafe(), err
+	}
+
+	m.schedMu.Lock()
+	err = m.scheduler().submit(newTicket(ec))
+	admitted = m.scheduler().drain()
+	m.schedMu.Unlock()
+
+	if err != nil {
+		ec.cleanup()
+		return nil, nil, ec.jobSafe(), err
+	}
 	m.contexts[j.Name] = ec
 
-	// make a new cgroup for the job specifically
-	cgroupJob := filepath.Join(m.cgroot, filepath.Base(j.Name))
-	cmd, cancel := makeCommand(ec.buf, cgroupJob, j.Cmd, j.Args...)
-	ec.stop = cancel
-	ec.StartTime = time.Now()
-	ec.State = Running
+	return ec, admitted, Job{}, nil
+}
+
+// prepare creates the private working directory of a new Job,
+// then moves the inputs into place.
+func (m *Executor) prepare(ec *execContext, in Inputs) error {
+	if m.workRoot == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(m.workRoot, 0o700); err != nil {
+		return fmt.Errorf("failed to create work root: %w", err)
+	}
+
+	// name by UID, since names are only unique per owner
+	dir := filepath.Join(m.workRoot, ec.UID)
+	if in.Dir != "" {
+		if err := os.Rename(in.Dir, dir); err != nil {
+			return fmt.Errorf("failed to move inputs: %w", err)
+		}
+	} else if err := os.Mkdir(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create working directory: %w", err)
+	}
+	ec.workDir = dir
+	ec.artifacts = dir + ".artifacts"
+
+	if len(ec.layers) > 0 {
+		ec.rootfs = dir + ".rootfs"
+		if err := createRootfs(ec.rootfs); err != nil {
+			ec.cleanup()
+			return err
+		}
+	}
+
+	if len(ec.Overlays) > 0 {
+		ec.overlay = dir + ".overlay"
+		if err := createOverlays(ec.overlay, ec.Overlays); err != nil {
+			ec.cleanup()
+			return err
+		}
+	}
+
+	if in.Stdin != "" {
+		// kept outside of the working directory, so it is hidden from the Job
+		stdin := dir + ".stdin"
+		if err := os.Rename(in.Stdin, stdin); err != nil {
+			ec.cleanup()
+			return fmt.Errorf("failed to move stdin: %w", err)
+		}
+		ec.stdin = stdin
+	}
+
+	return nil
+}
+
+// checkOverlays returns [ErrInvalidJob] if the Executor cannot overlay every directory.
+// Upper layers are kept in the work root, so it cannot be overlaid itself.
+func (m *Executor) checkOverlays(overlays []string) error {
+	if len(overlays) == 0 {
+		return nil
+	}
+	if m.workRoot == "" {
+		return invalidJob("overlays require a work root")
+	}
+	root, err := filepath.Abs(m.workRoot)
+	if err != nil {
+		return err
+	}
+	for _, dir := range overlays {
+		if within(dir, root) || within(root, dir) {
+			return invalidJob(fmt.Sprintf("overlay '%s' must not contain, or be in, the work root %s", dir, root))
+		}
+		if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+			return invalidJob(fmt.Sprintf("overlay '%s' must be an existing directory", dir))
+		}
+	}
+	return nil
+}
+
+// resolveImage looks up the image of j, and the directories of its layers.
+// [ErrInvalidJob] is returned if j cannot run in the image.
+func (m *Executor) resolveImage(j Job) (image.Image, []string, error) {
+	if j.Image == "" {
+		return image.Image{}, nil, nil
+	}
+	switch {
+	case m.images == nil:
+		return image.Image{}, nil, invalidJob("images require an image store")
+	case m.workRoot == "":
+		return image.Image{}, nil, invalidJob("images require a work root")
+	case len(j.Overlays) > 0:
+		// overlays are host directories, which are not in the root filesystem of the image
+		return image.Image{}, nil, invalidJob("overlays cannot be used with an image")
+	}
+
+	img, err := m.images.Get(j.Image)
+	var notFound *image.ErrNotFound
+	if errors.As(err, &notFound) {
+		return image.Image{}, nil, invalidJob(fmt.Sprintf("image '%s' not found", j.Image))
+	}
+	if err != nil {
+		return image.Image{}, nil, err
+	}
+	args := j.Args
+	if j.Cmd != "" {
+		args = append([]string{j.Cmd}, j.Args...)
+	}
+	if _, _, ok := img.Command(args); !ok {
+		return image.Image{}, nil, invalidJob(fmt.Sprintf("image '%s' has no entrypoint or command, and none was given", j.Image))
+	}
+	if len(img.Layers) == 0 {
+		return image.Image{}, nil, invalidJob(fmt.Sprintf("image '%s' has no layers", j.Image))
+	}
+
+	layers, err := m.images.LayerDirs(img)
+	if err != nil {
+		return image.Image{}, nil, err
+	}
+	return img, layers, nil
+}
+
+// resolveMounts checks that every bind source is allowed, and resolves its symlinks,
+// so a Job cannot escape its bind sources through a symlink it controls.
+// Sources may change before they are mounted, so [Reexec] checks them again, see [openBindSource].
+func (m *Executor) resolveMounts(mounts []Mount) ([]Mount, error) {
+	resolved := make([]Mount, 0, len(mounts))
+	for _, mount := range mounts {
+		if !filepath.IsAbs(mount.Target) && m.workRoot == "" {
+			return nil, invalidJob("relative mount targets require a work root")
+		}
+		if mount.Type == MountBind {
+			source, err := filepath.EvalSymlinks(mount.Source)
+			if err != nil || !slices.ContainsFunc(m.bindSources, func(allowed string) bool { return within(allowed, source) }) {
+				return nil, invalidJob(fmt.Sprintf("bind source '%s' is not allowed", mount.Source))
+			}
+			mount.Source = source
+		}
+		resolved = append(resolved, mount)
+	}
+	return resolved, nil
+}
 
-	if err := m.startCmd(cmd, ec.exit); err != nil {
-		return ec.jobSafe(), err
+// resolveBindSources resolves the symlinks of every bind source that exists.
+func resolveBindSources(sources []string) []string {
+	resolved := make([]string, 0, len(sources))
+	for _, source := range sources {
+		if p, err := filepath.EvalSymlinks(source); err == nil && filepath.IsAbs(p) {
+			resolved = append(resolved, p)
+		}
 	}
+	return resolved
+}
+
+// within returns true if path is dir, or beneath it. Both must be clean.
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && filepath.IsLocal(rel)
+}
 
-	slog.Info("Job started", slog.Any("job", ec.LogValue()))
+// seccompProfile loads the built-in seccomp profile with the given name, or the one in the
+// seccomp directory. Profiles are loaded once, when the Job is started, so every attempt
+// is filtered alike, even if the profiles in the seccomp directory change meanwhile.
+func (m *Executor) seccompProfile(name string) (seccomp.Profile, error) {
+	if p, ok := seccomp.Builtin(name); ok {
+		return p, nil
+	}
+	if m.seccompDir == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return seccomp.Profile{}, invalidJob(fmt.Sprintf("unknown seccomp profile '%s'", name))
+	}
 
-	return ec.jobSafe(), nil
+	p, err := seccomp.ReadFile(filepath.Join(m.seccompDir, name+".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return seccomp.Profile{}, invalidJob(fmt.Sprintf("unknown seccomp profile '%s'", name))
+	} else if err != nil {
+		return seccomp.Profile{}, invalidJob(err.Error())
+	}
+	return p, nil
+}
+
+// launch starts the subprocess for an admitted Job, then waits for its sandbox to be set up.
+// Capacity is released automatically when the Job exits,
+// or if its subprocess cannot be started, which fails the Job.
+// Every error before the subprocess is started goes through [Executor.failLaunch].
+//
+// [ErrSetupFailed] is returned if the sandbox could not be set up. The attempt is not restarted.
+func (m *Executor) launch(ec *execContext) error {
+	profile := ec.seccomp
+	rc := RuntimeConfig{
+		Args: append([]string{ec.Cmd}, ec.Args...),
+		Env:  append(os.Environ(), ec.Env...),
+		// make a new cgroup for the job specifically, names are only unique per owner
+		CgroupRoot:   filepath.Join(m.cgroot, ec.UID),
+		Limits:       ec.Limits,
+		Namespaces:   ec.namespaces,
+		Hostname:     ec.hostname,
+		WorkDir:      ec.workDir,
+		Seccomp:      &profile,
+		Capabilities: ec.capabilities,
+		User:         ec.RunAsUser,
+		Rlimits:      ec.Rlimits,
+		Landlock:     ec.Landlock,
+		Mounts:       slices.Clone(ec.mounts),
+		BindSources:  m.bindSources,
+		OverlayDir:   ec.overlay,
+		Overlays:     ec.Overlays,
+		Layers:       ec.layers,
+		RootfsDir:    ec.rootfs,
+		Cwd:          ec.cwd,
+	}
+	if len(ec.layers) > 0 {
+		// the environment of the host does not apply to the image
+		rc.Env = slices.Clone(ec.Env)
+	}
+	for i, mount := range rc.Mounts {
+		if !filepath.IsAbs(mount.Target) {
+			rc.Mounts[i].Target = filepath.Join(rc.WorkDir, mount.Target)
+		}
+	}
+	if !rc.Landlock.Empty() {
+		// the working directory, and every mount, is accessible as mounted
+		rc.Landlock.ReadOnly = slices.Clone(rc.Landlock.ReadOnly)
+		rc.Landlock.ReadWrite = slices.Clone(rc.Landlock.ReadWrite)
+		if rc.WorkDir != "" {
+			rc.Landlock.ReadWrite = append(rc.Landlock.ReadWrite, rc.WorkDir)
+		}
+		for _, mount := range rc.Mounts {
+			if mount.ReadOnly {
+				rc.Landlock.ReadOnly = append(rc.Landlock.ReadOnly, mount.Target)
+			} else {
+				rc.Landlock.ReadWrite = append(rc.Landlock.ReadWrite, mount.Target)
+			}
+		}
+	}
+	var stdin *os.File
+	if ec.stdin != "" {
+		// every attempt reads stdin from the start
+		f, err := os.Open(ec.stdin)
+		if err != nil {
+			return m.failLaunch(ec, fmt.Errorf("failed to open stdin: %w", err))
+		}
+		// the subprocess holds its own copy once started
+		defer f.Close()
+		stdin = f
+	}
+
+	cmd, control, cancel, err := makeCommand(ec.buf, rc)
+	if err != nil {
+		return m.failLaunch(ec, err)
+	}
+	// the reexec process waits for the control socket to close after a failed setup,
+	// otherwise the socket is kept until the attempt exits, see [Executor.Exec]
+	defer func() {
+		if control != nil {
+			control.Close()
+		}
+	}()
+	if stdin != nil {
+		cmd.Stdin = stdin
+	}
+
+	ec.begin(cancel)
+	attempt := len(ec.jobSafe().Attempts)
+
+	// a sandbox can exit before its setup result is read, which must be recorded
+	// first, so a failed setup is never restarted
+	settled := make(chan struct{})
+	// startCmd may report a failed start through done as well as its error
+	var once sync.Once
+	done := func(exitCode int) {
+		once.Do(func() {
+			if cmd.Process != nil {
+				<-settled
+			}
+			m.reap(ec)
+			restart := ec.exit(exitCode)
+			m.release(ec)
+			if restart {
+				m.scheduleRestart(ec)
+			}
+			m.changed.Broadcast()
+		})
+	}
+
+	// the subprocess holds its own copies once started, and the control socket
+	// only reports EOF once every copy of the child end is closed
+	extra := cmd.ExtraFiles
+	err = m.startCmd(cmd, done)
+	for _, f := range extra {
+		f.Close()
+	}
+	if err != nil {
+		ec.failSetup(attempt, err.Error())
+		close(settled)
+		done(CannotExecute)
+		return setupFailed(err.Error())
+	}
+
+	var pid, nspid int
+	if cmd.Process != nil {
+		pid = cmd.Process.Pid
+	}
+	if pid != 0 && len(cmd.ExtraFiles) > 0 {
+		res, err := awaitSetup(control)
+		var failed *ErrSetupFailed
+		if errors.As(err, &failed) {
+			ec.failSetup(attempt, failed.reason)
+			close(settled)
+			// kill a sandbox that is stuck, it never reported a result
+			cancel()
+			return err
+		}
+		nspid = res.PID
+	}
+	ec.running(attempt, pid, control)
+	control = nil
+	close(settled)
+
+	slog.Info("Job started", slog.Any("job", ec.jobSafe().LogValue()), slog.Int("pid", nspid))
+
+	return nil
+}
+
+// failLaunch fails an admitted Job whose attempt could not be launched before its
+// subprocess was started, as if it exited, so its capacity is released.
+// The attempt records err as its setup error, so the Job is not restarted.
+// [ErrSetupFailed] is returned with err as the reason.
+func (m *Executor) failLaunch(ec *execContext, err error) error {
+	ec.begin(nil)
+	ec.failSetup(len(ec.jobSafe().Attempts), err.Error())
+	ec.exit(CannotExecute)
+	m.release(ec)
+	m.changed.Broadcast()
+	return setupFailed(err.Error())
+}
+
+// reap terminates every process left in the cgroup of ec once its reexec process exited,
+// then removes the cgroup. Descendants that double-forked or ignored SIGTERM would otherwise
+// keep running after the Job terminated, and keep the cgroup from being removed.
+//
+// Remaining processes are sent SIGTERM, then killed with the cgroup once [StopGracePeriod]
+// elapses. reap blocks until the cgroup is empty, so the Job is only marked terminal
+// once all of its processes are gone.
+func (m *Executor) reap(ec *execContext) {
+	cg := filepath.Join(m.cgroot, ec.UID)
+	if _, err := os.Stat(filepath.Join(cg, "cgroup.events")); err != nil {
+		// the reexec process removed the empty cgroup, or never created it
+		return
+	}
+
+	grace, cancel := context.WithTimeout(context.Background(), StopGracePeriod)
+	defer cancel()
+	if err := cgroup2.Signal(cg, syscall.SIGTERM); err == nil {
+		_ = cgroup2.WaitEmpty(grace, cg)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), killTimeout)
+	defer cancel()
+	if err := cgroup2.Kill(ctx, cg); err != nil {
+		slog.Error("Failed to kill job cgroup", slog.String("name", ec.Name), slog.Any("error", err))
+		return
+	}
+	if err := cgroup2.Cleanup(cg); err != nil && !os.IsNotExist(err) {
+		slog.Error("Failed to remove job cgroup", slog.String("name", ec.Name), slog.Any("error", err))
+	}
+}
+
+// release gives back capacity held by ec, then launches
+// any queued Jobs that fit within the freed capacity.
+func (m *Executor) release(ec *execContext) {
+	m.schedMu.Lock()
+	m.scheduler().release(ec.Name)
+	admitted := m.scheduler().drain()
+	m.schedMu.Unlock()
+
+	for _, t := range admitted {
+		if err := m.launch(t.ec); err != nil {
+			slog.Error("Failed to start queued job", slog.Any("job", t.ec.jobSafe().LogValue()), slog.Any("error", err))
+		}
+	}
+}
+
+// scheduleRestart arms a timer to restart a [Restarting] Job after
+// an exponential backoff.
+func (m *Executor) scheduleRestart(ec *execContext) {
+	ec.m.Lock()
+	defer ec.m.Unlock()
+
+	// stopped before the timer was armed
+	if ec.State != Restarting {
+		return
+	}
+
+	ec.restart = time.AfterFunc(m.backoff(len(ec.Attempts)), func() {
+		m.restart(ec)
+	})
+}
+
+// restart queues the next attempt of a [Restarting] Job.
+func (m *Executor) restart(ec *execContext) {
+	ec.m.Lock()
+	if ec.State != Restarting {
+		ec.m.Unlock()
+		return
+	}
+	ec.State = Queued
+	ec.restart = nil
+	ec.m.Unlock()
+
+	m.schedMu.Lock()
+	err := m.scheduler().submit(newTicket(ec))
+	admitted := m.scheduler().drain()
+	m.schedMu.Unlock()
+
+	if err != nil {
+		slog.Error("Failed to queue restarted job", slog.Any("job", ec.jobSafe().LogValue()), slog.Any("error", err))
+	}
+
+	for _, t := range admitted {
+		if err := m.launch(t.ec); err != nil {
+			slog.Error("Failed to start queued job", slog.Any("job", t.ec.jobSafe().LogValue()), slog.Any("error", err))
+		}
+	}
+	m.changed.Broadcast()
+}
+
+// backoff returns the delay before the next attempt, given
+// the number of attempts made so far.
+func (m *Executor) backoff(attempts int) time.Duration {
+	d := m.restartBackoff
+	for i := 1; i < attempts && d < MaxRestartBackoff; i++ {
+		d *= 2
+	}
+	return min(d, MaxRestartBackoff)
+}
+
+// Changes returns a channel that is closed the next time any [Job]
+// managed by this Executor changes state.
+func (m *Executor) Changes() <-chan struct{} {
+	return m.changed.Wait()
+}
+
+// scheduler lazily initializes the scheduler.
+// m.schedMu must be held by the caller.
+func (m *Executor) scheduler() *scheduler {
+	if m.sched == nil {
+		m.sched = newScheduler(m.capacity)
+	}
+	return m.sched
 }
 
 // Stop a Job using the provided jobID. A non-nil error is returned
@@ -93,6 +793,11 @@ func (m *Executor) Start(j Job) (Job, error) {
 // with the given jobID exists.
 //
 // Calling Stop on a non-running Job is a no-op.
+//
+// Calling Stop on a [Queued] Job removes it from the queue
+// and the Job is never started. Calling Stop on a [Restarting]
+// Job prevents any further attempts. Calling Stop on a Job whose
+// sandbox is being set up stops it once the sandbox is set up.
 func (m *Executor) Stop(name string) error {
 	m.mu.Lock()
 	ec, err := m.lookupContext(name)
@@ -102,9 +807,238 @@ func (m *Executor) Stop(name string) error {
 		return err
 	}
 
+	m.schedMu.Lock()
+	dequeued := m.scheduler().cancel(name)
+	m.schedMu.Unlock()
+
+	if dequeued {
+		ec.cancel()
+		m.changed.Broadcast()
+		return nil
+	}
+
+	if ec.cancelRestart() {
+		m.changed.Broadcast()
+		return nil
+	}
+
+	if ec.stopStarting() {
+		m.changed.Broadcast()
+		return nil
+	}
+
+	ec.freeze.Lock()
+	defer ec.freeze.Unlock()
+
+	// frozen processes cannot handle the interrupt until they are thawed
+	if ec.jobSafe().State == Paused {
+		ctx, cancel := context.WithTimeout(context.Background(), FreezeTimeout)
+		defer cancel()
+		if err := cgroup2.Thaw(ctx, filepath.Join(m.cgroot, ec.UID)); err != nil {
+			return fmt.Errorf("failed to thaw job: %w", err)
+		}
+		ec.resumed()
+	}
+
 	return ec.interrupt()
 }
 
+// StopGracePeriod is how long the processes of a stopped [Job] may handle SIGTERM before they are killed.
+const StopGracePeriod = 5 * time.Second
+
+// killTimeout bounds how long the Executor waits for the kernel to kill every process of a [Job].
+const killTimeout = 10 * time.Second
+
+// FreezeTimeout bounds how long [Executor.Pause] and [Executor.Resume] wait
+// for the kernel to freeze or thaw every process of a [Job].
+const FreezeTimeout = 10 * time.Second
+
+// Pause freezes every process of the [Running] [Job] with the given name, including
+// processes started with [Executor.Exec], and waits until all of them are frozen.
+// The Job is [Paused] until [Executor.Resume] or [Executor.Stop] is called.
+// Time spent paused does not count toward the Job Timeout.
+//
+// An error is returned if the Job does not exist, [ErrInvalidJobState] if it is not running,
+// or if the processes are not frozen before ctx is done or [FreezeTimeout] elapses.
+func (m *Executor) Pause(ctx context.Context, name string) error {
+	m.mu.RLock()
+	ec, err := m.lookupContext(name)
+	m.mu.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	ec.freeze.Lock()
+	defer ec.freeze.Unlock()
+
+	if _, err := ec.sandbox(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, FreezeTimeout)
+	defer cancel()
+
+	cg := filepath.Join(m.cgroot, ec.UID)
+	err = cgroup2.Freeze(ctx, cg)
+	if err == nil && !ec.paused() {
+		// the Job exited or timed out while it was freezing
+		err = invalidJobState(ec.jobSafe().State)
+	}
+	if err != nil {
+		thawCtx, cancel := context.WithTimeout(context.Background(), FreezeTimeout)
+		defer cancel()
+		_ = cgroup2.Thaw(thawCtx, cg)
+		return err
+	}
+
+	m.changed.Broadcast()
+	return nil
+}
+
+// Resume thaws every process of the [Paused] [Job] with the given name,
+// and waits until all of them are running again.
+//
+// An error is returned if the Job does not exist, [ErrInvalidJobState] if it is not paused,
+// or if the processes are not thawed before ctx is done or [FreezeTimeout] elapses.
+func (m *Executor) Resume(ctx context.Context, name string) error {
+	m.mu.RLock()
+	ec, err := m.lookupContext(name)
+	m.mu.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	ec.freeze.Lock()
+	defer ec.freeze.Unlock()
+
+	if state := ec.jobSafe().State; state != Paused {
+		return invalidJobState(state)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, FreezeTimeout)
+	defer cancel()
+
+	if err := cgroup2.Thaw(ctx, filepath.Join(m.cgroot, ec.UID)); err != nil {
+		return err
+	}
+	ec.resumed()
+
+	m.changed.Broadcast()
+	return nil
+}
+
+// Remove a terminated [Job], all of its output, and its working directory. [ErrJobNotFound] is returned
+// if no job with the given name exists.
+//
+// [ErrInvalidJobState] is returned if the Job is queued or running.
+func (m *Executor) Remove(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ec, err := m.lookupContext(name)
+	if err != nil {
+		return err
+	}
+
+	if ec.Active() {
+		return invalidJobState(ec.jobSafe().State)
+	}
+
+	delete(m.contexts, name)
+	ec.cleanup()
+	m.changed.Broadcast()
+	return nil
+}
+
+// SearchOutput calls fn with every line of output from the [Job] with the given name
+// that matches s, in order. Only output written before the search began is searched,
+// so the search ends even if the Job is still running.
+// The search stops early if ctx is done or fn returns an error.
+//
+// An error is returned if the Job does not exist, or [ErrInvalidSearch] if s is malformed.
+func (m *Executor) SearchOutput(ctx context.Context, name string, s Search, fn func(Match) error) error {
+	re, err := s.compile()
+	if err != nil {
+		return err
+	}
+
+	m.mu.RLock()
+	ec, err := m.lookupContext(name)
+	m.mu.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	buf := ec.buffer()
+	size, _ := buf.Status()
+	r := buf.Reader()
+	defer r.Close()
+
+	return searchLines(ctx, io.LimitReader(r, int64(size)), re, s, fn)
+}
+
+// WriteArtifacts writes the artifacts collected from the [Job] with the given name
+// to w as a tar archive. Artifacts are collected once the Job terminates.
+//
+// An error is returned if the Job does not exist, or [ErrInvalidJobState] if it is still active.
+func (m *Executor) WriteArtifacts(name string, w io.Writer) error {
+	m.mu.RLock()
+	ec, err := m.lookupContext(name)
+	m.mu.RUnlock()
+
+	if err != nil {
+		return err
+	}
+	if job := ec.jobSafe(); job.Active() {
+		return invalidJobState(job.State)
+	}
+
+	return writeArtifacts(ec.artifacts, w)
+}
+
+// FilesystemDiff lists every file changed by the [Job] with the given name in its overlays,
+// compared to the host as it is now. Directories are listed before their contents.
+//
+// An error is returned if the Job does not exist, or [ErrInvalidJobState] if it is still active.
+func (m *Executor) FilesystemDiff(name string) ([]Change, error) {
+	m.mu.RLock()
+	ec, err := m.lookupContext(name)
+	m.mu.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+	job := ec.jobSafe()
+	if job.Active() {
+		return nil, invalidJobState(job.State)
+	}
+
+	return diffOverlays(ec.overlay, job.Overlays)
+}
+
+// WriteFilesystemDiff writes the changes of the [Job] with the given name in its overlays
+// to w as a tar archive, in the format of an OCI image layer.
+//
+// An error is returned if the Job does not exist, or [ErrInvalidJobState] if it is still active.
+func (m *Executor) WriteFilesystemDiff(name string, w io.Writer) error {
+	m.mu.RLock()
+	ec, err := m.lookupContext(name)
+	m.mu.RUnlock()
+
+	if err != nil {
+		return err
+	}
+	job := ec.jobSafe()
+	if job.Active() {
+		return invalidJobState(job.State)
+	}
+
+	return writeOverlays(ec.overlay, job.Overlays, w)
+}
+
 // Lookup returns a copy of any [Job] found. If no Job is found, a [ErrJobNotFound]
 // is returned and the Job value is zero.
 func (m *Executor) Lookup(name string) (job Job, err error) {
@@ -115,9 +1049,40 @@ func (m *Executor) Lookup(name string) (job Job, err error) {
 	if err == nil {
 		job = ec.jobSafe()
 	}
+
+	if job.State == Queued {
+		m.schedMu.Lock()
+		job.QueuePosition = m.scheduler().position(name)
+		m.schedMu.Unlock()
+	}
 	return
 }
 
+// List returns copies of all Jobs owned by owner that match sel, ordered by name.
+func (m *Executor) List(owner string, sel labels.Selector) []Job {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var jobs []Job
+	for _, ec := range m.contexts {
+		job := ec.jobSafe()
+		if job.Owner == owner && sel.Matches(job.Labels) {
+			jobs = append(jobs, job)
+		}
+	}
+
+	slices.SortFunc(jobs, func(a, b Job) int { return strings.Compare(a.Name, b.Name) })
+
+	m.schedMu.Lock()
+	defer m.schedMu.Unlock()
+	for i := range jobs {
+		if jobs[i].State == Queued {
+			jobs[i].QueuePosition = m.scheduler().position(jobs[i].Name)
+		}
+	}
+	return jobs
+}
+
 // OpenReader returns a [safe.NotifyingBufferReader] for reading STDOUT and STDERR from a [Job]. This method
 // may be used to get output from Jobs in any state.
 func (m *Executor) OpenReader(name string) (*safe.NotifyingBufferReader, error) {
@@ -142,10 +1107,14 @@ func (m *Executor) Wait(name string) error {
 		return err
 	}
 
+	// the output is closed once the Job terminates, which notifies
+	// waiters like any write, so Wait only wakes up when it changes
 	for {
-		if !ec.Running() {
+		notify := ec.buffer().Wait()
+		if !ec.Active() {
 			return nil
 		}
+		<-notify
 	}
 }
 
@@ -1,14 +1,31 @@
 package work
 
 import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"io"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
 	"sync"
+	"syscall"
 	"testing"
 	"time"
 
+	"github.com/drrev/telehandler/pkg/image"
+	"github.com/drrev/telehandler/pkg/labels"
 	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/pkg/seccomp"
+	"github.com/drrev/telehandler/pkg/spec"
 	"github.com/drrev/telehandler/tests/utils"
 )
 
@@ -21,10 +38,9 @@ func TestExecutor_Start(t *testing.T) {
 	}
 	mockStart := func(v *int, err error) commandStarter {
 		return func(c *exec.Cmd, done func(exitCode int)) error {
+			// done is never called for commands that cannot be started
 			if err == nil {
 				done(0)
-			} else {
-				done(1)
 			}
 			*v++
 			return err
@@ -71,29 +87,29 @@ func TestExecutor_Start(t *testing.T) {
 		{
 			name:      "start new job immediate exit",
 			fields:    fields{contexts: make(map[string]*execContext)},
-			args:      args{j: Job{Name: ""}},
+			args:      args{j: Job{Name: "", UID: "test"}},
 			wantErr:   utils.NoError(t),
 			startFn:   mockStart,
-			want:      Job{StartTime: time.Now(), State: Completed},
+			want:      Job{UID: "test", Seccomp: "default", StartTime: time.Now(), State: Completed, Attempts: []Attempt{{}}},
 			wantCalls: 1,
 		},
 		{
 			name:      "start new job",
 			fields:    fields{contexts: make(map[string]*execContext)},
-			args:      args{j: Job{Name: ""}},
+			args:      args{j: Job{Name: "", UID: "test"}},
 			wantErr:   utils.NoError(t),
 			startFn:   mockStartNoDone,
-			want:      Job{StartTime: time.Now(), State: Running},
+			want:      Job{UID: "test", Seccomp: "default", StartTime: time.Now(), State: Running, Attempts: []Attempt{{}}},
 			wantCalls: 1,
 		},
 		{
 			name:      "start new job with error",
 			fields:    fields{contexts: make(map[string]*execContext)},
-			args:      args{j: Job{Name: ""}},
+			args:      args{j: Job{Name: "", UID: "test"}},
 			wantErr:   utils.ErrorTextContains(t, "testing error"),
 			startFn:   mockStart,
 			injectErr: errors.New("testing error"),
-			want:      Job{StartTime: time.Now(), State: Failed, ExitCode: 1},
+			want:      Job{UID: "test", Seccomp: "default", StartTime: time.Now(), State: Failed, ExitCode: CannotExecute, Attempts: []Attempt{{ExitCode: CannotExecute, SetupError: "testing error"}}},
 			wantCalls: 1,
 		},
 	}
@@ -119,6 +135,10 @@ func TestExecutor_Start(t *testing.T) {
 			// ignore times for deep equals
 			tt.want.StartTime = got.StartTime
 			tt.want.EndTime = got.EndTime
+			for i := range got.Attempts {
+				got.Attempts[i].StartTime = time.Time{}
+				got.Attempts[i].EndTime = time.Time{}
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Executor.Start() = %+v, want %+v", got, tt.want)
 			}
@@ -261,7 +281,7 @@ func TestExecutor_Wait(t *testing.T) {
 		t.Errorf("Executor.Wait() error = %v", err)
 	}
 
-	m.contexts = map[string]*execContext{"": {}}
+	m.contexts = map[string]*execContext{"": {buf: safe.NewNotifyingBuffer()}}
 	if err := m.Wait(""); err != nil {
 		t.Errorf("Executor.Wait() error = %v", err)
 	}
@@ -289,6 +309,7 @@ func TestExecutor_Wait(t *testing.T) {
 	}
 
 	ec.m.Lock()
+	_ = ec.buf.Close()
 	ec.State = Completed
 	ec.m.Unlock()
 
@@ -298,3 +319,1272 @@ func TestExecutor_Wait(t *testing.T) {
 	case <-done:
 	}
 }
+
+func TestExecutor_StartQueued(t *testing.T) {
+	t.Parallel()
+	var done []func(exitCode int)
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		contexts: make(map[string]*execContext),
+		capacity: Capacity{Slots: 1},
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			done = append(done, fn)
+			return nil
+		},
+	}
+
+	for _, name := range []string{"a", "b", "c"} {
+		if _, err := m.Start(Job{Name: name}); err != nil {
+			t.Fatalf("Executor.Start() error = %v", err)
+		}
+	}
+
+	wantStates := map[string]JobState{"a": Running, "b": Queued, "c": Queued}
+	for name, want := range wantStates {
+		if got, _ := m.Lookup(name); got.State != want {
+			t.Errorf("Executor.Lookup(%v) state = %v, want %v", name, got.State, want)
+		}
+	}
+
+	if got, _ := m.Lookup("c"); got.QueuePosition != 2 {
+		t.Errorf("Executor.Lookup() queue position = %v, want 2", got.QueuePosition)
+	}
+
+	if err := m.Stop("b"); err != nil {
+		t.Fatalf("Executor.Stop() error = %v", err)
+	}
+	if got, _ := m.Lookup("b"); got.State != Stopped {
+		t.Errorf("Executor.Stop() queued job state = %v, want %v", got.State, Stopped)
+	}
+
+	// finishing a frees capacity for c
+	done[0](0)
+	if got, _ := m.Lookup("c"); got.State != Running {
+		t.Errorf("Executor.Lookup() state = %v, want %v", got.State, Running)
+	}
+	if len(done) != 2 {
+		t.Errorf("Executor started %v commands, want 2", len(done))
+	}
+}
+
+func TestExecutor_StartQueuedFailure(t *testing.T) {
+	t.Parallel()
+	var done []func(exitCode int)
+	starts := 0
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		contexts: make(map[string]*execContext),
+		capacity: Capacity{Slots: 1},
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			// the second command, of b, cannot be started
+			if starts++; starts == 2 {
+				return errors.New("testing error")
+			}
+			done = append(done, fn)
+			return nil
+		},
+	}
+
+	for _, name := range []string{"a", "b", "c"} {
+		if _, err := m.Start(Job{Name: name}); err != nil {
+			t.Fatalf("Executor.Start() error = %v", err)
+		}
+	}
+
+	// finishing a admits b, which cannot be started, so its capacity goes to c
+	done[0](0)
+	if got, _ := m.Lookup("b"); got.State != Failed || got.ExitCode != CannotExecute {
+		t.Errorf("Executor.Lookup() state = %v, exit code %v, want %v, exit code %v", got.State, got.ExitCode, Failed, CannotExecute)
+	}
+	if got, _ := m.Lookup("c"); got.State != Running {
+		t.Errorf("Executor.Lookup() state = %v, want %v", got.State, Running)
+	}
+	if len(done) != 2 {
+		t.Errorf("Executor started %v commands, want 2", len(done))
+	}
+}
+
+func TestExecutor_StartQueuedStdinMissing(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+	var done []func(exitCode int)
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		workRoot: filepath.Join(tmp, "jobs"),
+		contexts: make(map[string]*execContext),
+		capacity: Capacity{Slots: 1},
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			done = append(done, fn)
+			return nil
+		},
+	}
+
+	stdin := filepath.Join(tmp, "b.stdin")
+	if err := os.WriteFile(stdin, []byte("payload"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, j := range []Job{{Name: "a", UID: "a"}, {Name: "b", UID: "b", Inputs: Inputs{Stdin: stdin}}, {Name: "c", UID: "c"}} {
+		if _, err := m.Start(j); err != nil {
+			t.Fatalf("Executor.Start() error = %v", err)
+		}
+	}
+
+	// the stdin of b disappears while it is queued
+	ec, _ := m.lookupContext("b")
+	if err := os.Remove(ec.stdin); err != nil {
+		t.Fatal(err)
+	}
+
+	done[0](0)
+	got, _ := m.Lookup("b")
+	if got.State != Failed || len(got.Attempts) != 1 || !strings.Contains(got.Attempts[0].SetupError, "failed to open stdin") {
+		t.Errorf("Executor.Lookup() = %+v, want %v with a setup error", got, Failed)
+	}
+	if got, _ := m.Lookup("c"); got.State != Running {
+		t.Errorf("Executor.Lookup() state = %v, want %v", got.State, Running)
+	}
+}
+
+func TestExecutor_StartQueuedProfileMissing(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	var done []func(exitCode int)
+	m := &Executor{
+		mu:         sync.RWMutex{},
+		cgroot:     "/tmp",
+		seccompDir: dir,
+		contexts:   make(map[string]*execContext),
+		capacity:   Capacity{Slots: 1},
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			done = append(done, fn)
+			return nil
+		},
+	}
+
+	profile := filepath.Join(dir, "custom.json")
+	if err := os.WriteFile(profile, []byte(`{"defaultAction": "SCMP_ACT_ALLOW"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, j := range []Job{{Name: "a"}, {Name: "b", Seccomp: "custom"}, {Name: "c"}} {
+		if _, err := m.Start(j); err != nil {
+			t.Fatalf("Executor.Start() error = %v", err)
+		}
+	}
+
+	// the profile of b is deleted by the administrator while it is queued,
+	// but it was loaded when b was started
+	if err := os.Remove(profile); err != nil {
+		t.Fatal(err)
+	}
+
+	done[0](0)
+	if got, _ := m.Lookup("b"); got.State != Running {
+		t.Errorf("Executor.Lookup() = %+v, want %v", got, Running)
+	}
+	if got, _ := m.Lookup("c"); got.State != Queued {
+		t.Errorf("Executor.Lookup() state = %v, want %v", got.State, Queued)
+	}
+}
+
+func TestExecutor_Changes(t *testing.T) {
+	t.Parallel()
+	var done func(exitCode int)
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			done = fn
+			return nil
+		},
+	}
+
+	changes := m.Changes()
+	if _, err := m.Start(Job{Name: "a"}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+
+	select {
+	case <-changes:
+	default:
+		t.Error("Executor.Changes() not notified on start")
+	}
+
+	changes = m.Changes()
+	done(0)
+
+	select {
+	case <-changes:
+	default:
+		t.Error("Executor.Changes() not notified on exit")
+	}
+}
+
+func TestExecutor_Remove(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		contexts   map[string]*execContext
+		wantErr    func(error) bool
+		wantExists bool
+	}{
+		{
+			name:     "not found",
+			contexts: map[string]*execContext{},
+			wantErr:  utils.ErrorTextContains(t, "no job found"),
+		},
+		{
+			name:       "running",
+			contexts:   map[string]*execContext{"": {Job: Job{State: Running}}},
+			wantErr:    utils.ErrorTextContains(t, "invalid state"),
+			wantExists: true,
+		},
+		{
+			name:       "queued",
+			contexts:   map[string]*execContext{"": {Job: Job{State: Queued}}},
+			wantErr:    utils.ErrorTextContains(t, "invalid state"),
+			wantExists: true,
+		},
+		{
+			name:     "completed",
+			contexts: map[string]*execContext{"": {Job: Job{State: Completed}}},
+			wantErr:  utils.NoError(t),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Executor{
+				mu:       sync.RWMutex{},
+				contexts: tt.contexts,
+			}
+			if err := m.Remove(""); !tt.wantErr(err) {
+				t.Errorf("Executor.Remove() error = %v", err)
+			}
+			if _, ok := tt.contexts[""]; ok != tt.wantExists {
+				t.Errorf("Executor.Remove() job exists = %v, want %v", ok, tt.wantExists)
+			}
+		})
+	}
+}
+
+func TestExecutor_Restart(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		restart      RestartPolicy
+		maxAttempts  int
+		exitCodes    []int
+		wantState    JobState
+		wantAttempts []int
+	}{
+		{
+			name:         "never",
+			restart:      RestartNever,
+			exitCodes:    []int{1, 0},
+			wantState:    Failed,
+			wantAttempts: []int{1},
+		},
+		{
+			name:         "on failure until success",
+			restart:      RestartOnFailure,
+			exitCodes:    []int{1, 2, 0, 1},
+			wantState:    Completed,
+			wantAttempts: []int{1, 2, 0},
+		},
+		{
+			name:         "on failure max attempts",
+			restart:      RestartOnFailure,
+			maxAttempts:  2,
+			exitCodes:    []int{1, 2, 0},
+			wantState:    Failed,
+			wantAttempts: []int{1, 2},
+		},
+		{
+			name:         "always max attempts",
+			restart:      RestartAlways,
+			maxAttempts:  3,
+			exitCodes:    []int{0, 1, 0, 0},
+			wantState:    Completed,
+			wantAttempts: []int{0, 1, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			m := &Executor{
+				mu:             sync.RWMutex{},
+				cgroot:         "/tmp",
+				contexts:       make(map[string]*execContext),
+				restartBackoff: time.Millisecond,
+				startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+					code := tt.exitCodes[calls]
+					calls++
+					fn(code)
+					return nil
+				},
+			}
+
+			if _, err := m.Start(Job{Name: "a", Restart: tt.restart, MaxAttempts: tt.maxAttempts}); err != nil {
+				t.Fatalf("Executor.Start() error = %v", err)
+			}
+
+			deadline := time.After(2 * time.Second)
+			for {
+				changes := m.Changes()
+				if got, _ := m.Lookup("a"); !got.Active() {
+					break
+				}
+				select {
+				case <-changes:
+				case <-deadline:
+					t.Fatal("Executor.Start() job did not terminate")
+				}
+			}
+
+			got, _ := m.Lookup("a")
+			if got.State != tt.wantState {
+				t.Errorf("Executor.Start() state = %v, want %v", got.State, tt.wantState)
+			}
+
+			var codes []int
+			for _, a := range got.Attempts {
+				codes = append(codes, a.ExitCode)
+			}
+			if !reflect.DeepEqual(codes, tt.wantAttempts) {
+				t.Errorf("Executor.Start() attempt exit codes = %v, want %v", codes, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestExecutor_StopRestarting(t *testing.T) {
+	t.Parallel()
+	m := &Executor{
+		mu:             sync.RWMutex{},
+		cgroot:         "/tmp",
+		contexts:       make(map[string]*execContext),
+		restartBackoff: time.Hour,
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			fn(1)
+			return nil
+		},
+	}
+
+	if _, err := m.Start(Job{Name: "a", Restart: RestartAlways}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+	if got, _ := m.Lookup("a"); got.State != Restarting {
+		t.Fatalf("Executor.Start() state = %v, want %v", got.State, Restarting)
+	}
+
+	if err := m.Stop("a"); err != nil {
+		t.Fatalf("Executor.Stop() error = %v", err)
+	}
+	if got, _ := m.Lookup("a"); got.State != Stopped || len(got.Attempts) != 1 {
+		t.Errorf("Executor.Stop() = %+v, want %v with 1 attempt", got, Stopped)
+	}
+}
+
+func TestExecutor_StopStarting(t *testing.T) {
+	t.Parallel()
+	var (
+		m       *Executor
+		done    func(exitCode int)
+		stopErr error
+	)
+	m = &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			// Start must not hold the lock while the sandbox is set up
+			stopErr = m.Stop("a")
+			done = fn
+			return nil
+		},
+	}
+
+	if _, err := m.Start(Job{Name: "a", Restart: RestartAlways}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+	if stopErr != nil {
+		t.Fatalf("Executor.Stop() error = %v", stopErr)
+	}
+	ec, _ := m.lookupContext("a")
+	if ec.jobSafe().State != Running || ec.stop != nil {
+		t.Fatalf("Executor.Stop() did not interrupt the job once its sandbox was set up")
+	}
+
+	done(signalExitBase + int(syscall.SIGTERM))
+	if got, _ := m.Lookup("a"); got.State != Stopped || len(got.Attempts) != 1 {
+		t.Errorf("Executor.Stop() = %+v, want %v with 1 attempt", got, Stopped)
+	}
+}
+
+func TestExecutor_backoff(t *testing.T) {
+	t.Parallel()
+	m := &Executor{restartBackoff: time.Second}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 100, want: MaxRestartBackoff},
+	}
+	for _, tt := range tests {
+		if got := m.backoff(tt.attempts); got != tt.want {
+			t.Errorf("Executor.backoff(%v) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestExecutor_List(t *testing.T) {
+	t.Parallel()
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error { return nil },
+	}
+
+	for _, j := range []Job{
+		{Name: "c", Owner: "users/a", Labels: map[string]string{"team": "infra"}},
+		{Name: "a", Owner: "users/a", Labels: map[string]string{"team": "infra", "env": "dev"}},
+		{Name: "b", Owner: "users/a", Labels: map[string]string{"team": "web"}},
+		{Name: "d", Owner: "users/b", Labels: map[string]string{"team": "infra"}},
+	} {
+		if _, err := m.Start(j); err != nil {
+			t.Fatalf("Executor.Start() error = %v", err)
+		}
+	}
+
+	if _, err := m.Start(Job{Name: "e", Labels: map[string]string{"-bad": ""}}); !utils.ErrorTextContains(t, "is invalid")(err) {
+		t.Errorf("Executor.Start() error = %v", err)
+	}
+
+	tests := []struct {
+		selector string
+		want     []string
+	}{
+		{selector: "", want: []string{"a", "b", "c"}},
+		{selector: "team=infra", want: []string{"a", "c"}},
+		{selector: "team=infra,!env", want: []string{"c"}},
+		{selector: "team notin (infra,web)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			sel, err := labels.Parse(tt.selector)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, j := range m.List("users/a", sel) {
+				got = append(got, j.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Executor.List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecutor_Timeout(t *testing.T) {
+	t.Parallel()
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			// run a plain process that only exits once the timeout cancels it
+			c.Path = "/usr/bin/env"
+			c.Args = []string{"/usr/bin/env", "sleep", "60"}
+			c.SysProcAttr = nil
+			// sleep never reports a setup result
+			c.ExtraFiles = nil
+			return startCmd(c, fn)
+		},
+	}
+
+	if _, err := m.Start(Job{Name: "a", Timeout: 50 * time.Millisecond}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got, _ := m.Lookup("a")
+		if got.State == Failed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Executor.Start() state = %v, want %v after timeout", got.State, Failed)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestExecutor_Setup(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		// script stands in for the reexec process, the control socket is fd 3
+		script    string
+		wantErr   func(error) bool
+		wantState JobState
+		wantSetup string
+	}{
+		{
+			name:      "ready",
+			script:    `echo '{"pid":2}' >&3; exec 3>&-; exec sleep 60`,
+			wantErr:   utils.NoError(t),
+			wantState: Running,
+		},
+		{
+			name:      "failed",
+			script:    `echo '{"error":"no cgroup"}' >&3; cat <&3 >/dev/null; exit 1`,
+			wantErr:   utils.ErrorTextContains(t, "failed to set up job: no cgroup"),
+			wantState: Failed,
+			wantSetup: "no cgroup",
+		},
+		{
+			name:      "exited",
+			script:    `exit 1`,
+			wantErr:   utils.ErrorTextContains(t, "sandbox exited before it was set up"),
+			wantState: Failed,
+			wantSetup: "sandbox exited before it was set up",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := &Executor{
+				mu:       sync.RWMutex{},
+				cgroot:   "/tmp",
+				contexts: make(map[string]*execContext),
+				startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+					c.Path = "/bin/sh"
+					c.Args = []string{"/bin/sh", "-c", tt.script}
+					c.SysProcAttr = nil
+					return startCmd(c, fn)
+				},
+			}
+
+			// a failed setup is never restarted
+			_, err := m.Start(Job{Name: "a", UID: tt.name, Restart: RestartOnFailure})
+			if !tt.wantErr(err) {
+				t.Fatalf("Executor.Start() error = %v", err)
+			}
+			var setup *ErrSetupFailed
+			if err != nil && !errors.As(err, &setup) {
+				t.Errorf("Executor.Start() error = %v, want ErrSetupFailed", err)
+			}
+
+			if tt.wantState == Running {
+				if got, _ := m.Lookup("a"); got.State != Running {
+					t.Errorf("Executor.Start() state = %v, want %v", got.State, Running)
+				}
+				if err := m.Stop("a"); err != nil {
+					t.Errorf("Executor.Stop() error = %v", err)
+				}
+				return
+			}
+
+			deadline := time.Now().Add(5 * time.Second)
+			for {
+				got, _ := m.Lookup("a")
+				if !got.Active() {
+					if got.State != tt.wantState || len(got.Attempts) != 1 || got.Attempts[0].SetupError != tt.wantSetup {
+						t.Errorf("Executor.Start() = %+v, want %v after one attempt with setup error %q", got, tt.wantState, tt.wantSetup)
+					}
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("Executor.Start() state = %v, want %v", got.State, tt.wantState)
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestExecutor_seccompProfile(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	write := func(name, profile string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(profile), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("build.json", `{"defaultAction": "SCMP_ACT_ALLOW", "syscalls": [{"names": ["ptrace"], "action": "SCMP_ACT_KILL_PROCESS"}]}`)
+	write("broken.json", `{"defaultAction": "SCMP_ACT_TRACE"}`)
+
+	m := &Executor{seccompDir: dir}
+	tests := []struct {
+		name    string
+		want    seccomp.Action
+		wantErr func(error) bool
+	}{
+		{name: "default", want: seccomp.ActErrno, wantErr: utils.NoError(t)},
+		{name: "strict", want: seccomp.ActKillProcess, wantErr: utils.NoError(t)},
+		{name: "build", want: seccomp.ActAllow, wantErr: utils.NoError(t)},
+		{name: "broken", wantErr: utils.ErrorTextContains(t, "unsupported action")},
+		{name: "missing", wantErr: utils.ErrorTextContains(t, "unknown seccomp profile 'missing'")},
+		{name: "../build", wantErr: utils.ErrorTextContains(t, "unknown seccomp profile")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := m.seccompProfile(tt.name)
+			if !tt.wantErr(err) {
+				t.Errorf("Executor.seccompProfile() error = %v", err)
+			}
+			var invalid *ErrInvalidJob
+			if err != nil && !errors.As(err, &invalid) {
+				t.Errorf("Executor.seccompProfile() error = %v, want ErrInvalidJob", err)
+			}
+			if got.DefaultAction != tt.want {
+				t.Errorf("Executor.seccompProfile() default action = %v, want %v", got.DefaultAction, tt.want)
+			}
+		})
+	}
+
+	if _, err := (&Executor{}).seccompProfile("build"); err == nil {
+		t.Errorf("Executor.seccompProfile() should error without a seccomp directory")
+	}
+}
+
+func TestExecutor_Pause(t *testing.T) {
+	t.Parallel()
+	cgroot := t.TempDir()
+
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   cgroot,
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			// run a plain process that only exits once it is stopped or times out
+			c.Path = "/usr/bin/env"
+			c.Args = []string{"/usr/bin/env", "sleep", "60"}
+			c.SysProcAttr = nil
+			// sleep never reports a setup result
+			c.ExtraFiles = nil
+			return startCmd(c, fn)
+		},
+	}
+
+	// fake the cgroup of each job, since the kernel does not freeze a plain directory
+	frozen := func(uid string, v string) {
+		t.Helper()
+		dir := filepath.Join(cgroot, uid)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "cgroup.freeze"), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "cgroup.events"), []byte("populated 1\nfrozen "+v+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitFor := func(name string, want JobState) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			got, _ := m.Lookup(name)
+			if got.State == want {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("Executor.Lookup() state = %v, want %v", got.State, want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	ctx := context.Background()
+
+	var notFound *ErrJobNotFound
+	if err := m.Pause(ctx, "missing"); !errors.As(err, &notFound) {
+		t.Errorf("Executor.Pause() error = %v, want ErrJobNotFound", err)
+	}
+
+	frozen("a", "1")
+	if _, err := m.Start(Job{Name: "a", UID: "a", Timeout: 200 * time.Millisecond}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+
+	var state *ErrInvalidJobState
+	if err := m.Resume(ctx, "a"); !errors.As(err, &state) {
+		t.Errorf("Executor.Resume() error = %v, want ErrInvalidJobState for a running job", err)
+	}
+	if err := m.Pause(ctx, "a"); err != nil {
+		t.Fatalf("Executor.Pause() error = %v", err)
+	}
+	if err := m.Pause(ctx, "a"); !errors.As(err, &state) {
+		t.Errorf("Executor.Pause() error = %v, want ErrInvalidJobState for a paused job", err)
+	}
+
+	// the timeout is suspended while paused
+	time.Sleep(300 * time.Millisecond)
+	waitFor("a", Paused)
+
+	frozen("a", "0")
+	if err := m.Resume(ctx, "a"); err != nil {
+		t.Fatalf("Executor.Resume() error = %v", err)
+	}
+	waitFor("a", Failed)
+
+	// stopping a paused job thaws it first
+	frozen("b", "1")
+	if _, err := m.Start(Job{Name: "b", UID: "b"}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+	if err := m.Pause(ctx, "b"); err != nil {
+		t.Fatalf("Executor.Pause() error = %v", err)
+	}
+	frozen("b", "0")
+	if err := m.Stop("b"); err != nil {
+		t.Fatalf("Executor.Stop() error = %v", err)
+	}
+	waitFor("b", Stopped)
+}
+
+func TestExecutor_reap(t *testing.T) {
+	t.Parallel()
+	cgroot := t.TempDir()
+	m := &Executor{cgroot: cgroot}
+	ec := &execContext{Job: Job{Name: "a", UID: "a"}}
+
+	// the cgroup was already removed
+	m.reap(ec)
+
+	// fake a cgroup left holding a descendant of the job
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	cg := filepath.Join(cgroot, "a")
+	if err := os.MkdirAll(cg, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(cg, "cgroup.procs"), []byte(strconv.Itoa(cmd.Process.Pid)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(cg, "cgroup.events"), []byte("populated 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var waitErr error
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		waitErr = cmd.Wait()
+		_ = os.WriteFile(filepath.Join(cg, "cgroup.events"), []byte("populated 0\n"), 0o644)
+	}()
+
+	start := time.Now()
+	m.reap(ec)
+	<-exited
+
+	var exitErr *exec.ExitError
+	if !errors.As(waitErr, &exitErr) || exitErr.Sys().(syscall.WaitStatus).Signal() != syscall.SIGTERM {
+		t.Errorf("Executor.reap() process exited with %v, want SIGTERM", waitErr)
+	}
+	if d := time.Since(start); d >= StopGracePeriod {
+		t.Errorf("Executor.reap() took %v, want less than the grace period once the cgroup is empty", d)
+	}
+}
+
+func TestExecutor_Inputs(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+
+	var stdin []byte
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		workRoot: filepath.Join(tmp, "jobs"),
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			stdin, _ = io.ReadAll(c.Stdin)
+			fn(0)
+			return nil
+		},
+	}
+
+	stage := func(name string) Inputs {
+		dir := filepath.Join(tmp, "inputs", name)
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "script.sh"), []byte("echo hi"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(dir+".stdin", []byte("payload"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return Inputs{Dir: dir, Stdin: dir + ".stdin"}
+	}
+
+	in := stage("a")
+	if _, err := m.Start(Job{Name: "a", UID: "a", Inputs: in}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+	if data, err := os.ReadFile(filepath.Join(tmp, "jobs", "a", "script.sh")); err != nil || string(data) != "echo hi" {
+		t.Errorf("Executor.Start() working directory script.sh = %q, %v", data, err)
+	}
+	if string(stdin) != "payload" {
+		t.Errorf("Executor.Start() stdin = %q, want %q", stdin, "payload")
+	}
+	if _, err := os.Stat(in.Dir); !os.IsNotExist(err) {
+		t.Errorf("Executor.Start() staged inputs were not moved, stat error = %v", err)
+	}
+
+	if err := m.Remove("a"); err != nil {
+		t.Fatalf("Executor.Remove() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmp, "jobs", "a")); !os.IsNotExist(err) {
+		t.Errorf("Executor.Remove() working directory was not removed, stat error = %v", err)
+	}
+
+	// inputs are removed if the Job is not started
+	in = stage("b")
+	if _, err := m.Start(Job{Name: "b", UID: "b", Inputs: in, Timeout: -1}); err == nil {
+		t.Fatalf("Executor.Start() should reject an invalid job")
+	}
+	if _, err := os.Stat(in.Dir); !os.IsNotExist(err) {
+		t.Errorf("Executor.Start() staged inputs were not removed, stat error = %v", err)
+	}
+
+	m.workRoot = ""
+	in = stage("c")
+	if _, err := m.Start(Job{Name: "c", UID: "c", Inputs: in}); err == nil {
+		t.Fatalf("Executor.Start() should reject inputs without a work root")
+	}
+	if _, err := os.Stat(in.Stdin); !os.IsNotExist(err) {
+		t.Errorf("Executor.Start() staged stdin was not removed, stat error = %v", err)
+	}
+}
+
+func TestExecutor_WriteArtifacts(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		workRoot: tmp,
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			// the job writes into its working directory
+			_ = os.WriteFile(filepath.Join(tmp, "a", "report.txt"), []byte("done"), 0o644)
+			_ = os.WriteFile(filepath.Join(tmp, "a", "scratch"), []byte("tmp"), 0o644)
+			fn(0)
+			return nil
+		},
+	}
+
+	if _, err := m.Start(Job{Name: "a", UID: "a", Artifacts: []string{"*.txt"}}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := m.WriteArtifacts("a", &buf); err != nil {
+		t.Fatalf("Executor.WriteArtifacts() error = %v", err)
+	}
+	tr := tar.NewReader(&buf)
+	if hdr, err := tr.Next(); err != nil || hdr.Name != "report.txt" {
+		t.Errorf("Executor.WriteArtifacts() first entry = %v, %v, want report.txt", hdr, err)
+	}
+	if _, err := tr.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Executor.WriteArtifacts() should only archive matching files, error = %v", err)
+	}
+
+	if err := m.WriteArtifacts("missing", &buf); err == nil {
+		t.Errorf("Executor.WriteArtifacts() should error for a missing job")
+	}
+
+	m.workRoot = ""
+	if _, err := m.Start(Job{Name: "b", UID: "b", Artifacts: []string{"*.txt"}}); err == nil {
+		t.Errorf("Executor.Start() should reject artifacts without a work root")
+	}
+}
+
+func TestExecutor_resolveMounts(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	allowed := filepath.Join(dir, "datasets")
+	if err := os.MkdirAll(filepath.Join(allowed, "images"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "secrets"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(dir, "secrets"), filepath.Join(allowed, "escape")); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Executor{workRoot: dir, bindSources: resolveBindSources([]string{allowed, filepath.Join(dir, "missing")})}
+	tests := []struct {
+		name    string
+		mount   Mount
+		want    Mount
+		wantErr func(error) bool
+	}{
+		{
+			name:    "allowed",
+			mount:   Mount{Type: MountBind, Source: allowed, Target: "/data", ReadOnly: true},
+			want:    Mount{Type: MountBind, Source: allowed, Target: "/data", ReadOnly: true},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "beneath allowed",
+			mount:   Mount{Type: MountBind, Source: filepath.Join(allowed, "images"), Target: "images"},
+			want:    Mount{Type: MountBind, Source: filepath.Join(allowed, "images"), Target: "images"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "tmpfs",
+			mount:   Mount{Type: MountTmpfs, Target: "scratch"},
+			want:    Mount{Type: MountTmpfs, Target: "scratch"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "not allowed",
+			mount:   Mount{Type: MountBind, Source: filepath.Join(dir, "secrets"), Target: "/data"},
+			wantErr: utils.ErrorTextContains(t, "is not allowed"),
+		},
+		{
+			name:    "symlink out of allowed",
+			mount:   Mount{Type: MountBind, Source: filepath.Join(allowed, "escape"), Target: "/data"},
+			wantErr: utils.ErrorTextContains(t, "is not allowed"),
+		},
+		{
+			name:    "missing",
+			mount:   Mount{Type: MountBind, Source: filepath.Join(allowed, "missing"), Target: "/data"},
+			wantErr: utils.ErrorTextContains(t, "is not allowed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := m.resolveMounts([]Mount{tt.mount})
+			if !tt.wantErr(err) {
+				t.Fatalf("Executor.resolveMounts() error = %v", err)
+			}
+			if err == nil && !reflect.DeepEqual(got, []Mount{tt.want}) {
+				t.Errorf("Executor.resolveMounts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := (&Executor{}).resolveMounts([]Mount{{Type: MountTmpfs, Target: "scratch"}}); err == nil {
+		t.Errorf("Executor.resolveMounts() should error on a relative target without a work root")
+	}
+}
+
+func TestExecutor_checkOverlays(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	workRoot := filepath.Join(dir, "work")
+	if err := os.MkdirAll(filepath.Join(workRoot, "jobs"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "etc"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Executor{workRoot: workRoot}
+	tests := []struct {
+		name     string
+		overlays []string
+		wantErr  func(error) bool
+	}{
+		{name: "none", wantErr: utils.NoError(t)},
+		{name: "directory", overlays: []string{filepath.Join(dir, "etc")}, wantErr: utils.NoError(t)},
+		{name: "contains work root", overlays: []string{dir}, wantErr: utils.ErrorTextContains(t, "must not contain, or be in, the work root")},
+		{name: "in work root", overlays: []string{filepath.Join(workRoot, "jobs")}, wantErr: utils.ErrorTextContains(t, "must not contain, or be in, the work root")},
+		{name: "missing", overlays: []string{filepath.Join(dir, "missing")}, wantErr: utils.ErrorTextContains(t, "must be an existing directory")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := m.checkOverlays(tt.overlays); !tt.wantErr(err) {
+				t.Errorf("Executor.checkOverlays() error = %v", err)
+			}
+		})
+	}
+
+	if err := (&Executor{}).checkOverlays([]string{filepath.Join(dir, "etc")}); err == nil {
+		t.Errorf("Executor.checkOverlays() should error without a work root")
+	}
+}
+
+// importImage imports an image with a single empty layer into s.
+func importImage(t *testing.T, s *image.Store, name string, entrypoint []string, cmd []string) {
+	t.Helper()
+	blobs := map[string][]byte{}
+	blob := func(mediaType string, data []byte) map[string]any {
+		sum := sha256.Sum256(data)
+		d := "sha256:" + hex.EncodeToString(sum[:])
+		blobs[d] = data
+		return map[string]any{"mediaType": mediaType, "digest": d, "size": len(data)}
+	}
+	marshal := func(v any) []byte {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	var layer bytes.Buffer
+	if err := tar.NewWriter(&layer).Close(); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(layer.Bytes())
+	config := marshal(map[string]any{
+		"os":           runtime.GOOS,
+		"architecture": runtime.GOARCH,
+		"config":       map[string]any{"Env": []string{"PATH=/bin", "FOO=image"}, "Entrypoint": entrypoint, "Cmd": cmd, "WorkingDir": "/srv"},
+		"rootfs":       map[string]any{"type": "layers", "diff_ids": []string{"sha256:" + hex.EncodeToString(sum[:])}},
+	})
+	manifest := blob("application/vnd.oci.image.manifest.v1+json", marshal(map[string]any{
+		"config": blob("application/vnd.oci.image.config.v1+json", config),
+		"layers": []any{blob("application/vnd.oci.image.layer.v1.tar", layer.Bytes())},
+	}))
+	manifest["annotations"] = map[string]string{"org.opencontainers.image.ref.name": name}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	files := map[string][]byte{
+		"oci-layout": []byte(`{"imageLayoutVersion":"1.0.0"}`),
+		"index.json": marshal(map[string]any{"manifests": []any{manifest}}),
+	}
+	for d, data := range blobs {
+		files["blobs/sha256/"+d[len("sha256:"):]] = data
+	}
+	for name, data := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Import(&buf); err != nil {
+		t.Fatalf("Store.Import() error = %v", err)
+	}
+}
+
+func TestExecutor_Image(t *testing.T) {
+	t.Parallel()
+	tmp := t.TempDir()
+	store, err := image.NewStore(filepath.Join(tmp, "images"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	importImage(t, store, "app", []string{"/bin/app"}, []string{"--serve"})
+	importImage(t, store, "empty", nil, nil)
+	lower := t.TempDir()
+
+	tests := []struct {
+		name     string
+		job      Job
+		wantCmd  string
+		wantArgs []string
+		wantEnv  []string
+		wantErr  func(error) bool
+	}{
+		{
+			name:     "defaults",
+			job:      Job{Image: "app", Env: []string{"FOO=job"}},
+			wantCmd:  "/bin/app",
+			wantArgs: []string{"--serve"},
+			wantEnv:  []string{"PATH=/bin", "FOO=image", "FOO=job"},
+			wantErr:  utils.NoError(t),
+		},
+		{
+			name:     "replace cmd",
+			job:      Job{Image: "app", Cmd: "--once", Args: []string{"-v"}},
+			wantCmd:  "/bin/app",
+			wantArgs: []string{"--once", "-v"},
+			wantEnv:  []string{"PATH=/bin", "FOO=image"},
+			wantErr:  utils.NoError(t),
+		},
+		{
+			name:     "no entrypoint",
+			job:      Job{Image: "empty", Cmd: "/bin/sh", Args: []string{"-c", "true"}},
+			wantCmd:  "/bin/sh",
+			wantArgs: []string{"-c", "true"},
+			wantEnv:  []string{"PATH=/bin", "FOO=image"},
+			wantErr:  utils.NoError(t),
+		},
+		{
+			name:    "no command",
+			job:     Job{Image: "empty"},
+			wantErr: utils.ErrorTextContains(t, "image 'empty' has no entrypoint or command, and none was given"),
+		},
+		{
+			name:    "not found",
+			job:     Job{Image: "missing"},
+			wantErr: utils.ErrorTextContains(t, "image 'missing' not found"),
+		},
+		{
+			name:    "overlays",
+			job:     Job{Image: "app", Overlays: []string{lower}},
+			wantErr: utils.ErrorTextContains(t, "overlays cannot be used with an image"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var started RuntimeConfig
+			m := &Executor{
+				mu:       sync.RWMutex{},
+				cgroot:   "/tmp",
+				workRoot: filepath.Join(tmp, tt.name),
+				images:   store,
+				contexts: make(map[string]*execContext),
+				startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+					s, err := spec.Parse(c.ExtraFiles[1])
+					if err != nil {
+						return err
+					}
+					started, err = runtimeConfig(s)
+					fn(0)
+					return err
+				},
+			}
+
+			tt.job.Name, tt.job.UID = "a", "a"
+			got, err := m.Start(tt.job)
+			if !tt.wantErr(err) {
+				t.Fatalf("Executor.Start() error = %v", err)
+			}
+			if err != nil {
+				var invalid *ErrInvalidJob
+				if !errors.As(err, &invalid) {
+					t.Errorf("Executor.Start() error = %v, want ErrInvalidJob", err)
+				}
+				return
+			}
+
+			if got.Cmd != tt.wantCmd || !reflect.DeepEqual(got.Args, tt.wantArgs) || !reflect.DeepEqual(got.Env, tt.wantEnv) {
+				t.Errorf("Executor.Start() = %v %v %v, want %v %v %v", got.Cmd, got.Args, got.Env, tt.wantCmd, tt.wantArgs, tt.wantEnv)
+			}
+			// the environment of the host is not passed into the image
+			if !reflect.DeepEqual(started.Env, tt.wantEnv) {
+				t.Errorf("Executor.Start() command env = %v, want %v", started.Env, tt.wantEnv)
+			}
+			layers, _ := store.LayerDirs(mustGet(t, store, tt.job.Image))
+			if !reflect.DeepEqual(started.Layers, layers) || started.RootfsDir != filepath.Join(m.workRoot, "a.rootfs") || started.Cwd != "/srv" {
+				t.Errorf("Executor.Start() command layers = %v in %v, cwd %v, want %v in %v, cwd /srv", started.Layers, started.RootfsDir, started.Cwd, layers, filepath.Join(m.workRoot, "a.rootfs"))
+			}
+		})
+	}
+
+	m := &Executor{workRoot: tmp, contexts: make(map[string]*execContext)}
+	if _, err := m.Start(Job{Name: "a", Image: "app"}); err == nil {
+		t.Errorf("Executor.Start() should reject an image without an image store")
+	}
+}
+
+func mustGet(t *testing.T, s *image.Store, name string) image.Image {
+	t.Helper()
+	img, err := s.Get(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return img
+}
+
+func TestExecutor_Namespaces(t *testing.T) {
+	t.Parallel()
+	defaults := []string{"mount", "pid", "user", "ipc", "cgroup"}
+
+	tests := []struct {
+		name           string
+		job            Job
+		wantNamespaces []string
+		wantHostname   string
+		wantErr        func(error) bool
+	}{
+		{
+			name:           "defaults",
+			wantNamespaces: defaults,
+			wantErr:        utils.NoError(t),
+		},
+		{
+			name:           "uts",
+			job:            Job{Namespaces: []string{"mount", "pid", "user", "uts", "cgroup"}},
+			wantNamespaces: []string{"mount", "pid", "user", "uts", "cgroup"},
+			wantHostname:   DefaultHostname,
+			wantErr:        utils.NoError(t),
+		},
+		{
+			name:           "hostname",
+			job:            Job{Namespaces: []string{"mount", "pid", "user", "uts", "cgroup"}, Hostname: "build"},
+			wantNamespaces: []string{"mount", "pid", "user", "uts", "cgroup"},
+			wantHostname:   "build",
+			wantErr:        utils.NoError(t),
+		},
+		{
+			name:    "hostname without uts",
+			job:     Job{Hostname: "build"},
+			wantErr: utils.ErrorTextContains(t, "hostname requires a uts namespace"),
+		},
+		{
+			name:    "missing required",
+			job:     Job{Namespaces: []string{"mount", "user"}},
+			wantErr: utils.ErrorTextContains(t, "namespace pid is required"),
+		},
+		{
+			name:    "drops non-droppable",
+			job:     Job{Namespaces: []string{"mount", "pid", "user", "ipc", "uts"}},
+			wantErr: utils.ErrorTextContains(t, "namespace cgroup cannot be dropped"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var started RuntimeConfig
+			m := &Executor{
+				mu:         sync.RWMutex{},
+				cgroot:     "/tmp",
+				namespaces: defaults,
+				droppable:  []string{"ipc"},
+				contexts:   make(map[string]*execContext),
+				startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+					s, err := spec.Parse(c.ExtraFiles[1])
+					if err != nil {
+						return err
+					}
+					started, err = runtimeConfig(s)
+					fn(0)
+					return err
+				},
+			}
+
+			tt.job.Name, tt.job.UID, tt.job.Cmd = "a", "a", "true"
+			_, err := m.Start(tt.job)
+			if !tt.wantErr(err) {
+				t.Fatalf("Executor.Start() error = %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(started.Namespaces, tt.wantNamespaces) || started.Hostname != tt.wantHostname {
+				t.Errorf("Executor.Start() command namespaces = %v, hostname %q, want %v, hostname %q", started.Namespaces, started.Hostname, tt.wantNamespaces, tt.wantHostname)
+			}
+		})
+	}
+}
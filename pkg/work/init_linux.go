@@ -0,0 +1,194 @@
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"sync"
+	"syscall"
+)
+
+// signalExitBase is added to the number of the signal that killed the main process
+// of a [Job] to form its exit code, following the convention of shells and tini.
+const signalExitBase = 128
+
+// runInit acts as a minimal init for the PID namespace of a [Job] until the main
+// process with the given pid exits, then returns its exit code.
+//
+// Every signal received on sigs is forwarded to the process group of the main process,
+// except SIGCHLD, which triggers reaping, and SIGURG, which the Go runtime uses internally.
+// Every child is reaped, including orphans reparented to the init, so none are left as zombies,
+// and the exit of every process in procs is reported, unless procs is nil.
+// Once ctx is done, SIGTERM is forwarded as if it was received.
+//
+// The main process must lead its own process group, and sigs must be notified
+// of SIGCHLD before the main process is started.
+func runInit(ctx context.Context, pid int, sigs <-chan os.Signal, procs *execProcs) int {
+	done := ctx.Done()
+	for {
+		if ws, ok := reapChildren(pid, procs); ok {
+			return exitCode(ws)
+		}
+
+		select {
+		case sig := <-sigs:
+			s, ok := sig.(syscall.Signal)
+			if !ok || s == syscall.SIGCHLD || s == syscall.SIGURG {
+				continue
+			}
+			_ = syscall.Kill(-pid, s)
+		case <-done:
+			done = nil
+			_ = syscall.Kill(-pid, syscall.SIGTERM)
+		}
+	}
+}
+
+// reapChildren waits for every child that already exited, without blocking.
+// Returns the wait status of the process with the given pid, if it was reaped.
+// Any other child is reported to procs, unless procs is nil.
+func reapChildren(pid int, procs *execProcs) (status syscall.WaitStatus, exited bool) {
+	for {
+		var ws syscall.WaitStatus
+		reaped, err := syscall.Wait4(-1, &ws, syscall.WNOHANG, nil)
+		if errors.Is(err, syscall.EINTR) {
+			continue
+		}
+		if err != nil || reaped <= 0 {
+			// ECHILD once no children are left
+			return status, exited
+		}
+		if reaped == pid {
+			status, exited = ws, true
+		} else if procs != nil {
+			procs.exited(reaped, ws)
+		}
+	}
+}
+
+// exitCode converts the wait status of the main process into the exit code of the init.
+func exitCode(ws syscall.WaitStatus) int {
+	switch {
+	case ws.Exited():
+		return ws.ExitStatus()
+	case ws.Signaled():
+		return signalExitBase + int(ws.Signal())
+	}
+	return 255
+}
+
+// execProcs are the processes started by the init for [Executor.Exec], with the exec socket
+// of each, by pid. mu is held while a process is started, until it is added, so it cannot
+// be reaped before, and a pid is only signaled until its process is reaped.
+type execProcs struct {
+	mu    sync.Mutex
+	conns map[int]*net.UnixConn
+}
+
+// newExecProcs creates an empty set of processes.
+func newExecProcs() *execProcs {
+	return &execProcs{conns: make(map[int]*net.UnixConn)}
+}
+
+// exited reports the exit of the process with the given pid, if it was started
+// for [Executor.Exec], then closes its exec socket.
+func (p *execProcs) exited(pid int, ws syscall.WaitStatus) {
+	p.mu.Lock()
+	conn, ok := p.conns[pid]
+	delete(p.conns, pid)
+	p.mu.Unlock()
+
+	if ok {
+		_ = sendExecResult(conn, execResult{Exited: true, ExitCode: exitCode(ws)})
+		conn.Close()
+	}
+}
+
+// serveExec starts a process for every exec socket received over control, see [requestExec],
+// until the Executor closes control once the attempt exits. Processes are started with the
+// same restrictions as the command of rc, into the cgroup open as cgroup.
+func serveExec(control *net.UnixConn, rc RuntimeConfig, cgroup *os.File, procs *execProcs) {
+	defer control.Close()
+	for {
+		_, files, err := receiveFiles(control, 1, 1)
+		if err != nil {
+			return
+		}
+		for _, f := range files {
+			fc, err := net.FileConn(f)
+			f.Close()
+			if conn, ok := fc.(*net.UnixConn); err == nil && ok {
+				go procs.start(conn, rc, cgroup)
+			}
+		}
+	}
+}
+
+// start reads an [execRequest] from conn, and starts its process, in the working directory,
+// and with the credentials and restrictions, of the command of rc. Its [execResult]s are
+// sent over conn, and it is killed once the Executor shuts down its end of conn.
+func (p *execProcs) start(conn *net.UnixConn, rc RuntimeConfig, cgroup *os.File) {
+	pid, err := p.startRequested(conn, rc, cgroup)
+	if err != nil {
+		_ = sendExecResult(conn, execResult{Error: err.Error()})
+		conn.Close()
+		return
+	}
+
+	// the Executor never sends anything else, so any read returns once it is done
+	_, _ = conn.Read(make([]byte, 1))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conns[pid] == conn {
+		_ = syscall.Kill(pid, syscall.SIGKILL)
+	}
+}
+
+// startRequested starts the process of the [execRequest] read from conn,
+// and reports that it started. Returns the pid of the process.
+func (p *execProcs) startRequested(conn *net.UnixConn, rc RuntimeConfig, cgroup *os.File) (int, error) {
+	data, files, err := receiveFiles(conn, maxExecRequest, 3)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read exec request: %w", err)
+	}
+	// the process holds its own copies once started
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	var req execRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return 0, fmt.Errorf("malformed exec request: %w", err)
+	}
+	if len(req.Args) == 0 || len(files) != 3 {
+		return 0, errors.New("exec request needs a command, and its standard input, output, and error")
+	}
+
+	cmd := rc.command(req.Args, append(slices.Clone(rc.Env), req.Env...), cgroup)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = files[0], files[1], files[2]
+	// the process is not a member of the process group of the command,
+	// so signals forwarded by the init do not reach it
+	cmd.SysProcAttr.Setsid = true
+	if req.TTY {
+		cmd.SysProcAttr.Setctty = true
+		cmd.SysProcAttr.Ctty = 0
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := startRestricted(cmd, rc); err != nil {
+		return 0, err
+	}
+	pid := cmd.Process.Pid
+	p.conns[pid] = conn
+	// reported before the process can be reaped, so the exit is always reported last
+	_ = sendExecResult(conn, execResult{})
+	return pid, nil
+}
@@ -0,0 +1,70 @@
+package work
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// Test_runInit is not parallel, since the init reaps every child of the test process.
+func Test_runInit(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		// signal is forwarded once the main process is started, if set
+		signal syscall.Signal
+		// cancel the context once the main process is started
+		cancel bool
+		want   int
+	}{
+		{
+			name:   "exit code",
+			script: "sleep 0.05 & exit 3",
+			want:   3,
+		},
+		{
+			name:   "forwarded signal",
+			script: `trap "exit 7" USR1; while :; do sleep 0.01; done`,
+			signal: syscall.SIGUSR1,
+			want:   7,
+		},
+		{
+			name:   "killed by signal",
+			script: "exec sleep 60",
+			cancel: true,
+			want:   signalExitBase + int(syscall.SIGTERM),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sigs := make(chan os.Signal, 32)
+			signal.Notify(sigs, syscall.SIGCHLD)
+			defer signal.Stop(sigs)
+
+			cmd := exec.Command("sh", "-c", tt.script)
+			cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+			if err := cmd.Start(); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.signal != 0 {
+				// give the shell time to install its trap
+				time.Sleep(100 * time.Millisecond)
+				sigs <- tt.signal
+			}
+			if tt.cancel {
+				cancel()
+			}
+
+			if got := runInit(ctx, cmd.Process.Pid, sigs, nil); got != tt.want {
+				t.Errorf("runInit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,10 +1,18 @@
 package work
 
 import (
+	"fmt"
 	"log/slog"
+	"math"
+	"os"
 	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/drrev/telehandler/pkg/labels"
+	"github.com/drrev/telehandler/pkg/landlock"
 	"github.com/google/uuid"
 )
 
@@ -13,6 +21,8 @@ import (
 type JobState string
 
 const (
+	// The job is waiting for capacity before it can run.
+	Queued JobState = "JOB_STATE_QUEUED"
 	// The job is currently running and active.
 	Running JobState = "JOB_STATE_RUNNING"
 	// The job failed during execution.
@@ -22,39 +32,238 @@ const (
 	Completed JobState = "JOB_STATE_COMPLETED"
 	// The job was stopped by a user before completing execution.
 	Stopped JobState = "JOB_STATE_STOPPED"
+	// The process exited and the job is waiting to be restarted
+	// according to its [RestartPolicy].
+	Restarting JobState = "JOB_STATE_RESTARTING"
+	// The processes of the job are frozen by a user, and continue
+	// where they left off once the job is resumed.
+	Paused JobState = "JOB_STATE_PAUSED"
 )
 
+// RestartPolicy determines if a [Job] is restarted after its process exits.
+type RestartPolicy string
+
+const (
+	// Never restart the job. This is the default.
+	RestartNever RestartPolicy = "RESTART_POLICY_NEVER"
+	// Restart the job only if the process exits with a non-zero exit code.
+	RestartOnFailure RestartPolicy = "RESTART_POLICY_ON_FAILURE"
+	// Restart the job regardless of the exit code.
+	RestartAlways RestartPolicy = "RESTART_POLICY_ALWAYS"
+)
+
+// Attempt is a single execution of the process for a [Job].
+type Attempt struct {
+	// StartTime of when the subprocess began execution.
+	StartTime time.Time
+	// EndTime is the time that the subprocess terminated.
+	// This field is only valid once the attempt has exited.
+	EndTime time.Time
+	// ExitCode captures the exit_code of the subprocess.
+	// This field is only valid once the attempt has exited.
+	ExitCode int
+	// OutputStart is the offset of the first byte of Job output
+	// written by this attempt.
+	OutputStart int64
+	// OutputEnd is the offset following the last byte of Job output
+	// written by this attempt.
+	// This field is only valid once the attempt has exited.
+	OutputEnd int64
+	// SetupError describes why the sandbox of this attempt could not be set up.
+	// Empty unless the command never ran.
+	SetupError string
+	// TerminationReason describes why the sandbox likely killed the subprocess, as inferred
+	// from its exit code. System calls denied by seccomp with an errno are not reported.
+	// This field is only valid once the attempt has exited.
+	TerminationReason string
+}
+
 // Job represents a command context.
 type Job struct {
 	Name string
+	// UID is a system-assigned UUID that is unique across all owners.
+	// It is assigned by [Executor.Start] if empty.
+	UID string
 	// Owner that created this Job.
 	Owner string
 	// Cmd path to an executable to run for this Job.
 	Cmd string
 	// Args passed to the subprocess.
 	Args []string
-	// StartTime of when the subprocess began execution.
+	// Env lists additional KEY=VALUE environment variables for the subprocess.
+	Env []string
+	// Limits constrain the resources used by the subprocess.
+	Limits Limits
+	// Timeout is the maximum duration of each attempt. The subprocess
+	// is terminated once the timeout elapses. Zero means no timeout.
+	Timeout time.Duration
+	// Inputs are staged files moved into the private working directory
+	// of the Job when it is started.
+	Inputs Inputs
+	// Artifacts lists glob patterns, relative to the private working directory,
+	// of regular files that are collected once the Job terminates.
+	Artifacts []string
+	// Seccomp is the name of the seccomp profile that filters the system calls
+	// of the subprocess. Defaults to the built-in "default" profile.
+	Seccomp string
+	// Capabilities changes the capability bounding set of the subprocess.
+	// Defaults to [DefaultCapabilities].
+	Capabilities Capabilities
+	// RunAsUser is the uid, and gid, of the subprocess in the user namespace of the Job.
+	// Zero runs the subprocess as root in the namespace.
+	RunAsUser int
+	// Rlimits are the resource limits of the subprocess, see setrlimit(2).
+	Rlimits []Rlimit
+	// Cwd is the absolute working directory of the subprocess. Defaults to the working
+	// directory of the Image, or the private working directory of the Job.
+	Cwd string
+	// Namespaces lists the types of the namespaces created for the subprocess, such as
+	// [spec.NamespaceIPC]. Must include [RequiredNamespaces]. Defaults to [Settings.Namespaces].
+	Namespaces []string
+	// Hostname of the subprocess, which requires a UTS namespace. Defaults to [DefaultHostname].
+	Hostname string
+	// Landlock restricts the host paths the subprocess can access. The private working
+	// directory is always read-write. Nothing is restricted if empty.
+	Landlock landlock.Ruleset
+	// Mounts are performed in order, within the mount namespace of the subprocess.
+	Mounts []Mount
+	// Overlays lists host directories that the subprocess can write to without changing
+	// the host. Changes are written to a copy-on-write layer kept until the Job is removed,
+	// see [Executor.FilesystemDiff].
+	Overlays []string
+	// Image is the name, or digest, of an image in the store of the Executor, see [Settings.Images].
+	// If set, the subprocess runs in a copy-on-write root filesystem of the image. Cmd and Args
+	// replace the command of the image, and are appended to its entrypoint, in the manner of Docker.
+	// The environment of the image is prepended to Env.
+	Image string
+	// Priority orders Jobs waiting for capacity. Higher values run first.
+	Priority int
+	// Labels identify the Job for selection, see [labels.Selector].
+	Labels map[string]string
+	// Annotations hold arbitrary metadata that is not used for selection.
+	Annotations map[string]string
+	// Restart determines if the subprocess is restarted after it exits.
+	Restart RestartPolicy
+	// MaxAttempts is the maximum number of times the subprocess is run,
+	// including the first attempt. Zero means unlimited.
+	// This field is ignored if Restart is [RestartNever].
+	MaxAttempts int
+	// Attempts lists every execution of the subprocess, oldest first.
+	// All attempts share the same Job output.
+	Attempts []Attempt
+	// StartTime of when the first subprocess began execution.
 	StartTime time.Time
 	// EndTime is the time that the job terminated.
 	// This field is only valid if State != Running.
 	EndTime time.Time
 	State   JobState
-	// ExitCode captures the exit_code of the subprocess.
+	// ExitCode captures the exit_code of the last subprocess.
 	// This field is only valid if State != Running.
 	ExitCode int
+	// QueuePosition is the 1-based position of this Job in the run queue.
+	// This field is only valid if State == Queued.
+	QueuePosition int
+}
+
+// DefaultCPULimit is the CPU limit, in millicores, of a [Job] if [Limits.CPU] is not set.
+// This matches the default cpu.max constraint applied to every Job cgroup.
+const DefaultCPULimit int64 = 100
+
+// Limits constrain the resources used by a single [Job].
+// A zero value for any field uses the default.
+type Limits struct {
+	// CPU is the maximum CPU bandwidth in millicores, where 1000 is one full CPU.
+	// Defaults to [DefaultCPULimit].
+	CPU int64
+	// Memory is the maximum number of bytes of memory.
+	// Defaults to [DefaultMemoryReservation].
+	Memory int64
 }
 
+// memory returns the number of bytes of memory the Job may use.
+func (l Limits) memory() int64 {
+	if l.Memory > 0 {
+		return l.Memory
+	}
+	return DefaultMemoryReservation
+}
+
+// Rlimit is a resource limit of the subprocess of a [Job].
+type Rlimit struct {
+	// Type of the limit, such as RLIMIT_NOFILE.
+	Type string
+	// Soft is the limit enforced by the kernel, which the subprocess can raise up to Hard.
+	Soft uint64
+	Hard uint64
+}
+
+// Capabilities changes the capability bounding set of a [Job], starting from [DefaultCapabilities].
+// Names are case-insensitive, and the CAP_ prefix is optional. "ALL" names every capability.
+type Capabilities struct {
+	// Add lists capabilities added to the default set.
+	Add []string
+	// Drop lists capabilities removed from the default set. Drop is applied before Add.
+	Drop []string
+}
+
+// Inputs are files staged on the host for a single [Job].
+// [Executor.Start] takes ownership of Inputs: they are moved into place
+// if the Job is started, or removed if it is not.
+type Inputs struct {
+	// Dir is a directory whose contents become the initial contents
+	// of the private working directory of the Job.
+	Dir string
+	// Stdin is a file passed as standard input to every attempt.
+	Stdin string
+}
+
+// empty returns true if no inputs are staged.
+func (in Inputs) empty() bool {
+	return in.Dir == "" && in.Stdin == ""
+}
+
+// remove deletes all staged inputs.
+func (in Inputs) remove() {
+	for _, p := range []string{in.Dir, in.Stdin} {
+		if p != "" {
+			_ = os.RemoveAll(p)
+		}
+	}
+}
+
+// jobIDPattern restricts client-chosen Job IDs to AIP-122 resource IDs.
+var jobIDPattern = regexp.MustCompile(`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
 // NewJob creates a [Job] with a randomly generated UUID and the given
 // owner, cmd, and args.
 func NewJob(owner string, cmd string, args []string) *Job {
+	uid := uuid.New().String()
 	return &Job{
-		Name:  path.Join(owner, "/jobs/", uuid.New().String()),
+		Name:  path.Join(owner, "/jobs/", uid),
+		UID:   uid,
 		Owner: owner,
 		Cmd:   cmd,
 		Args:  args,
 	}
 }
 
+// NewJobWithID creates a [Job] with a client-chosen ID and the given
+// owner, cmd, and args. [ErrInvalidJobID] is returned if the ID does not
+// follow https://google.aip.dev/122#resource-id-segments.
+func NewJobWithID(owner string, id string, cmd string, args []string) (*Job, error) {
+	if !jobIDPattern.MatchString(id) {
+		return nil, invalidJobID(id)
+	}
+
+	return &Job{
+		Name:  path.Join(owner, "/jobs/", id),
+		UID:   uuid.New().String(),
+		Owner: owner,
+		Cmd:   cmd,
+		Args:  args,
+	}, nil
+}
+
 // Identity returns the unique [Job] identifier.
 func (j *Job) Identity() string {
 	return j.Name
@@ -66,6 +275,94 @@ func (j *Job) Running() bool {
 	return j.State == Running
 }
 
+// Active is a convenience function to check
+// if the [Job] is [Queued], [Running], [Paused], or [Restarting].
+func (j *Job) Active() bool {
+	return j.State == Queued || j.State == Running || j.State == Paused || j.State == Restarting
+}
+
+// restartable checks if the [RestartPolicy] allows another attempt
+// after an attempt exited with the given exit code.
+func (j *Job) restartable(exitCode int) bool {
+	switch {
+	case j.Restart == RestartAlways:
+	case j.Restart == RestartOnFailure && exitCode != 0:
+	default:
+		return false
+	}
+	return j.MaxAttempts <= 0 || len(j.Attempts) < j.MaxAttempts
+}
+
+// validate checks the runtime configuration and user metadata of the [Job].
+func (j *Job) validate() error {
+	if err := validateEnv(j.Env); err != nil {
+		return err
+	}
+	if j.Limits.CPU < 0 || j.Limits.Memory < 0 {
+		return invalidJob("limits must not be negative")
+	}
+	if j.Timeout < 0 {
+		return invalidJob("timeout must not be negative")
+	}
+	// (uid_t)-1 is never a valid uid
+	if j.RunAsUser < 0 || j.RunAsUser >= math.MaxUint32 {
+		return invalidJob(fmt.Sprintf("user must be between 0 and %d", uint32(math.MaxUint32-1)))
+	}
+	if err := validateRlimits(j.Rlimits); err != nil {
+		return err
+	}
+	if j.Cwd != "" && (!filepath.IsAbs(j.Cwd) || filepath.Clean(j.Cwd) != j.Cwd) {
+		return invalidJob(fmt.Sprintf("working directory '%s' must be absolute and clean", j.Cwd))
+	}
+	if j.Namespaces != nil {
+		if err := ValidateNamespaces(j.Namespaces); err != nil {
+			return err
+		}
+	}
+	if j.Hostname != "" {
+		if err := validateHostname(j.Hostname); err != nil {
+			return err
+		}
+	}
+	for _, mount := range j.Mounts {
+		if err := mount.validate(); err != nil {
+			return err
+		}
+	}
+	for i, dir := range j.Overlays {
+		if !filepath.IsAbs(dir) || filepath.Clean(dir) != dir {
+			return invalidJob(fmt.Sprintf("overlay '%s' must be absolute and clean", dir))
+		}
+		for _, other := range j.Overlays[:i] {
+			if within(dir, other) || within(other, dir) {
+				return invalidJob(fmt.Sprintf("overlays '%s' and '%s' must not contain each other", other, dir))
+			}
+		}
+	}
+	if err := j.Landlock.Validate(); err != nil {
+		return invalidJob(err.Error())
+	}
+	for _, pattern := range j.Artifacts {
+		if _, err := filepath.Match(pattern, ""); err != nil || !filepath.IsLocal(pattern) {
+			return invalidJob(fmt.Sprintf("artifact pattern '%s' must be a valid glob relative to the working directory", pattern))
+		}
+	}
+	if err := labels.ValidateLabels(j.Labels); err != nil {
+		return err
+	}
+	return labels.ValidateAnnotations(j.Annotations)
+}
+
+// validateEnv checks that every environment variable is formatted as KEY=VALUE.
+func validateEnv(env []string) error {
+	for _, kv := range env {
+		if k, _, ok := strings.Cut(kv, "="); !ok || k == "" {
+			return invalidJob(fmt.Sprintf("environment variable '%s' must be formatted as KEY=VALUE", kv))
+		}
+	}
+	return nil
+}
+
 // LogValue implements slog.LogValuer.
 func (j Job) LogValue() slog.Value {
 	return slog.GroupValue(
@@ -74,5 +371,8 @@ func (j Job) LogValue() slog.Value {
 		slog.String("state", string(j.State)),
 		slog.String("cmd", j.Cmd),
 		slog.Any("args", j.Args),
+		slog.Int("priority", j.Priority),
+		slog.Any("labels", j.Labels),
+		slog.Int("attempts", len(j.Attempts)),
 	)
 }
@@ -0,0 +1,95 @@
+package work
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/landlock"
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestNewJobWithID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		id       string
+		wantName string
+		wantErr  func(error) bool
+	}{
+		{id: "nightly-build", wantName: "users/test/jobs/nightly-build", wantErr: utils.NoError(t)},
+		{id: "a", wantName: "users/test/jobs/a", wantErr: utils.NoError(t)},
+		{id: "", wantErr: utils.ErrorTextContains(t, "is invalid")},
+		{id: "Build", wantErr: utils.ErrorTextContains(t, "is invalid")},
+		{id: "1build", wantErr: utils.ErrorTextContains(t, "is invalid")},
+		{id: "build-", wantErr: utils.ErrorTextContains(t, "is invalid")},
+		{id: "build/1", wantErr: utils.ErrorTextContains(t, "is invalid")},
+		{id: strings.Repeat("a", 64), wantErr: utils.ErrorTextContains(t, "is invalid")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			j, err := NewJobWithID("users/test", tt.id, "echo", nil)
+			if !tt.wantErr(err) {
+				t.Fatalf("NewJobWithID() error = %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if j.Name != tt.wantName {
+				t.Errorf("NewJobWithID() Name = %v, want %v", j.Name, tt.wantName)
+			}
+			if j.UID == "" || j.UID == tt.id {
+				t.Errorf("NewJobWithID() UID = %v, want a generated UUID", j.UID)
+			}
+		})
+	}
+}
+
+func TestJob_validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		job     Job
+		wantErr func(error) bool
+	}{
+		{name: "empty", wantErr: utils.NoError(t)},
+		{name: "env", job: Job{Env: []string{"A=1", "B="}}, wantErr: utils.NoError(t)},
+		{name: "env missing value", job: Job{Env: []string{"A"}}, wantErr: utils.ErrorTextContains(t, "KEY=VALUE")},
+		{name: "env missing key", job: Job{Env: []string{"=1"}}, wantErr: utils.ErrorTextContains(t, "KEY=VALUE")},
+		{name: "negative limits", job: Job{Limits: Limits{Memory: -1}}, wantErr: utils.ErrorTextContains(t, "limits must not be negative")},
+		{name: "negative user", job: Job{RunAsUser: -1}, wantErr: utils.ErrorTextContains(t, "user must be between")},
+		{name: "landlock", job: Job{Landlock: landlock.Ruleset{ReadOnly: []string{"/usr"}, ReadWrite: []string{"/tmp"}}}, wantErr: utils.NoError(t)},
+		{name: "landlock relative", job: Job{Landlock: landlock.Ruleset{ReadOnly: []string{"usr"}}}, wantErr: utils.ErrorTextContains(t, "must be absolute")},
+		{name: "overlays", job: Job{Overlays: []string{"/etc", "/var/lib"}}, wantErr: utils.NoError(t)},
+		{name: "overlay relative", job: Job{Overlays: []string{"etc"}}, wantErr: utils.ErrorTextContains(t, "must be absolute and clean")},
+		{name: "overlays nested", job: Job{Overlays: []string{"/var", "/var/lib"}}, wantErr: utils.ErrorTextContains(t, "must not contain each other")},
+		{name: "invalid user", job: Job{RunAsUser: math.MaxUint32}, wantErr: utils.ErrorTextContains(t, "user must be between")},
+		{name: "artifacts", job: Job{Artifacts: []string{"out/*.log", "report.txt"}}, wantErr: utils.NoError(t)},
+		{name: "artifact outside", job: Job{Artifacts: []string{"../*.log"}}, wantErr: utils.ErrorTextContains(t, "relative to the working directory")},
+		{name: "artifact absolute", job: Job{Artifacts: []string{"/etc/*"}}, wantErr: utils.ErrorTextContains(t, "relative to the working directory")},
+		{name: "artifact malformed", job: Job{Artifacts: []string{"["}}, wantErr: utils.ErrorTextContains(t, "relative to the working directory")},
+		{name: "negative timeout", job: Job{Timeout: -time.Second}, wantErr: utils.ErrorTextContains(t, "timeout must not be negative")},
+		{name: "rlimits", job: Job{Rlimits: []Rlimit{{Type: "RLIMIT_NOFILE", Soft: 64, Hard: 128}, {Type: "RLIMIT_CORE"}}}, wantErr: utils.NoError(t)},
+		{name: "unknown rlimit", job: Job{Rlimits: []Rlimit{{Type: "RLIMIT_FILES"}}}, wantErr: utils.ErrorTextContains(t, "unknown rlimit 'RLIMIT_FILES'")},
+		{name: "repeated rlimit", job: Job{Rlimits: []Rlimit{{Type: "RLIMIT_CORE"}, {Type: "RLIMIT_CORE"}}}, wantErr: utils.ErrorTextContains(t, "set more than once")},
+		{name: "rlimit soft above hard", job: Job{Rlimits: []Rlimit{{Type: "RLIMIT_NOFILE", Soft: 2, Hard: 1}}}, wantErr: utils.ErrorTextContains(t, "must not exceed its hard limit")},
+		{name: "cwd", job: Job{Cwd: "/srv"}, wantErr: utils.NoError(t)},
+		{name: "cwd relative", job: Job{Cwd: "srv"}, wantErr: utils.ErrorTextContains(t, "must be absolute and clean")},
+		{name: "namespaces", job: Job{Namespaces: []string{"mount", "pid", "user", "network", "time"}}, wantErr: utils.NoError(t)},
+		{name: "namespaces missing required", job: Job{Namespaces: []string{"mount", "pid", "uts"}}, wantErr: utils.ErrorTextContains(t, "namespace user is required")},
+		{name: "namespaces empty", job: Job{Namespaces: []string{}}, wantErr: utils.ErrorTextContains(t, "is required")},
+		{name: "namespace unsupported", job: Job{Namespaces: []string{"mount", "pid", "user", "net"}}, wantErr: utils.ErrorTextContains(t, "namespace 'net' is not supported")},
+		{name: "namespace repeated", job: Job{Namespaces: []string{"mount", "pid", "user", "pid"}}, wantErr: utils.ErrorTextContains(t, "listed more than once")},
+		{name: "hostname", job: Job{Hostname: "build-1.ci"}, wantErr: utils.NoError(t)},
+		{name: "hostname invalid", job: Job{Hostname: "build_1"}, wantErr: utils.ErrorTextContains(t, "hostname 'build_1' must be")},
+		{name: "hostname empty label", job: Job{Hostname: "build..ci"}, wantErr: utils.ErrorTextContains(t, "hostname 'build..ci' must be")},
+		{name: "hostname too long", job: Job{Hostname: strings.Repeat("a", 65)}, wantErr: utils.ErrorTextContains(t, "at most 64 characters")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.job.validate(); !tt.wantErr(err) {
+				t.Errorf("Job.validate() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package work
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// MountType is the kind of a [Mount].
+type MountType string
+
+const (
+	// MountBind binds a host path into the sandbox.
+	MountBind MountType = "bind"
+	// MountTmpfs mounts an empty tmpfs, limited in size, into the sandbox.
+	MountTmpfs MountType = "tmpfs"
+)
+
+// DefaultTmpfsSize is the size, in bytes, of a tmpfs [Mount] if [Mount.Size] is not set.
+const DefaultTmpfsSize int64 = 64 << 20
+
+// Mount is performed within the mount namespace of a [Job], so it is not visible to the host.
+//
+// Mounts are formatted like the --mount flag of Docker, see [ParseMount].
+type Mount struct {
+	Type MountType
+	// Source is the host path of a bind mount. It must be beneath a bind source
+	// allowed by the administrator, see [Settings.BindSources]. Unused for tmpfs.
+	Source string
+	// Target is the path of the mount in the sandbox. It is either absolute, and must exist,
+	// or relative to the private working directory of the Job, and is created.
+	Target string
+	// ReadOnly prevents the Job from writing to a bind mount.
+	ReadOnly bool
+	// Size is the maximum number of bytes of a tmpfs, which are charged to the memory
+	// limit of the Job when used. Defaults to [DefaultTmpfsSize].
+	Size int64
+}
+
+// ParseMount parses a mount formatted as comma-separated key=value pairs:
+// type (bind or tmpfs), source, target, readonly, and tmpfs-size in bytes.
+// For example:
+//
+//	type=bind,source=/srv/datasets,target=/data,readonly
+//	type=tmpfs,target=scratch,tmpfs-size=1073741824
+func ParseMount(s string) (Mount, error) {
+	var m Mount
+	for _, field := range strings.Split(s, ",") {
+		key, value, hasValue := strings.Cut(field, "=")
+		switch key {
+		case "type":
+			m.Type = MountType(value)
+		case "source", "src":
+			m.Source = value
+		case "target", "dst", "destination":
+			m.Target = value
+		case "readonly", "ro":
+			if !hasValue {
+				value = "true"
+			}
+			ro, err := strconv.ParseBool(value)
+			if err != nil {
+				return m, invalidJob(fmt.Sprintf("mount '%s' has an invalid readonly value '%s'", s, value))
+			}
+			m.ReadOnly = ro
+		case "tmpfs-size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return m, invalidJob(fmt.Sprintf("mount '%s' has an invalid tmpfs-size '%s'", s, value))
+			}
+			m.Size = size
+		default:
+			return m, invalidJob(fmt.Sprintf("mount '%s' has an unknown option '%s'", s, key))
+		}
+	}
+	return m, m.validate()
+}
+
+// String formats m as parsed by [ParseMount].
+func (m Mount) String() string {
+	fields := []string{"type=" + string(m.Type)}
+	if m.Source != "" {
+		fields = append(fields, "source="+m.Source)
+	}
+	fields = append(fields, "target="+m.Target)
+	if m.ReadOnly {
+		fields = append(fields, "readonly")
+	}
+	if m.Size > 0 {
+		fields = append(fields, "tmpfs-size="+strconv.FormatInt(m.Size, 10))
+	}
+	return strings.Join(fields, ",")
+}
+
+// size returns the number of bytes of a tmpfs.
+func (m Mount) size() int64 {
+	if m.Size > 0 {
+		return m.Size
+	}
+	return DefaultTmpfsSize
+}
+
+// validate checks that m is well-formed, but not that its source is allowed.
+func (m Mount) validate() error {
+	for _, p := range []string{m.Source, m.Target} {
+		if strings.Contains(p, ",") {
+			return invalidJob(fmt.Sprintf("mount path '%s' must not contain commas", p))
+		}
+	}
+	if m.Target == "" || filepath.Clean(m.Target) != m.Target || m.Target == "/" || m.Target == "." {
+		return invalidJob(fmt.Sprintf("mount target '%s' must be a clean path other than / or .", m.Target))
+	}
+	if !filepath.IsAbs(m.Target) && !filepath.IsLocal(m.Target) {
+		return invalidJob(fmt.Sprintf("mount target '%s' must be absolute, or relative to the working directory", m.Target))
+	}
+
+	switch m.Type {
+	case MountBind:
+		if !filepath.IsAbs(m.Source) || filepath.Clean(m.Source) != m.Source {
+			return invalidJob(fmt.Sprintf("bind source '%s' must be absolute and clean", m.Source))
+		}
+		if m.Size != 0 {
+			return invalidJob("tmpfs-size is only valid for tmpfs mounts")
+		}
+	case MountTmpfs:
+		if m.Source != "" {
+			return invalidJob("source is only valid for bind mounts")
+		}
+		if m.ReadOnly {
+			return invalidJob("tmpfs mounts cannot be read-only")
+		}
+		if m.Size < 0 {
+			return invalidJob("tmpfs-size must not be negative")
+		}
+	default:
+		return invalidJob(fmt.Sprintf("mount type '%s' must be bind or tmpfs", m.Type))
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package work
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"golang.org/x/sys/unix"
+)
+
+// mountAll performs every mount of rc in order, within the mount namespace of the reexec process.
+// Missing targets are created beneath the working directory, which must already be isolated.
+func mountAll(rc RuntimeConfig) error {
+	for _, m := range rc.Mounts {
+		if err := mount(rc, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mount performs m. A bind source is opened before its target is created, so the
+// target is created for the source that is mounted, even if its path changes meanwhile.
+func mount(rc RuntimeConfig, m Mount) error {
+	var source *os.File
+	if m.Type == MountBind {
+		f, err := openBindSource(m.Source, rc.BindSources)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		source = f
+	}
+
+	if err := createTarget(rc.WorkDir, m, source); err != nil {
+		return fmt.Errorf("failed to create mount target %s: %w", m.Target, err)
+	}
+
+	switch m.Type {
+	case MountBind:
+		if err := bind(m, source); err != nil {
+			return fmt.Errorf("failed to bind %s to %s: %w", m.Source, m.Target, err)
+		}
+	case MountTmpfs:
+		data := fmt.Sprintf("mode=0755,size=%d", m.size())
+		if err := unix.Mount("tmpfs", m.Target, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, data); err != nil {
+			return fmt.Errorf("failed to mount tmpfs on %s: %w", m.Target, err)
+		}
+	default:
+		return fmt.Errorf("unknown mount type '%s'", m.Type)
+	}
+	return nil
+}
+
+// openBindSource opens path without following any symlink, then checks that it is still
+// beneath one of allowed. The Executor resolved the symlinks of path when the Job was started,
+// so a symlink found now was swapped in since, and could point anywhere on the host.
+//
+// The returned file is opened with O_PATH, so it can only be mounted, see [bind].
+func openBindSource(path string, allowed []string) (*os.File, error) {
+	fd, err := unix.Openat2(unix.AT_FDCWD, path, &unix.OpenHow{
+		Flags:   unix.O_PATH | unix.O_CLOEXEC,
+		Resolve: unix.RESOLVE_NO_SYMLINKS,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bind source %s: %w", path, err)
+	}
+	f := os.NewFile(uintptr(fd), path)
+
+	// the path of the opened file, in case path changed while it was resolved
+	real, err := os.Readlink(fdPath(f))
+	if err != nil || !slices.ContainsFunc(allowed, func(a string) bool { return within(a, real) }) {
+		f.Close()
+		return nil, fmt.Errorf("bind source '%s' is not allowed", path)
+	}
+	return f, nil
+}
+
+// fdPath returns the path of f within procfs, which refers to f itself, not to its path.
+func fdPath(f *os.File) string {
+	return fmt.Sprintf("/proc/self/fd/%d", f.Fd())
+}
+
+// bind source to the target of m recursively. Setuid binaries and devices are never
+// usable through a bind mount.
+func bind(m Mount, source *os.File) error {
+	if err := unix.Mount(fdPath(source), m.Target, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return err
+	}
+
+	// a remount only changes the top mount, so submounts would remain writable
+	attr := unix.MountAttr{Attr_set: unix.MOUNT_ATTR_NOSUID | unix.MOUNT_ATTR_NODEV}
+	if m.ReadOnly {
+		attr.Attr_set |= unix.MOUNT_ATTR_RDONLY
+	}
+	return unix.MountSetattr(unix.AT_FDCWD, m.Target, unix.AT_RECURSIVE, &attr)
+}
+
+// createTarget creates the target of m if it is missing, and beneath workDir.
+// A bind mount of a file needs a file as its target, everything else needs a directory.
+// source is the opened source of a bind mount, and nil otherwise.
+func createTarget(workDir string, m Mount, source *os.File) error {
+	if _, err := os.Lstat(m.Target); !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if workDir == "" || !within(workDir, m.Target) {
+		return fmt.Errorf("%w outside of the working directory", fs.ErrNotExist)
+	}
+	// an earlier attempt may have replaced a parent with a symlink out of the working directory
+	if err := checkBeneath(workDir, filepath.Dir(m.Target)); err != nil {
+		return err
+	}
+
+	dir := true
+	if source != nil {
+		fi, err := source.Stat()
+		if err != nil {
+			return err
+		}
+		dir = fi.IsDir()
+	}
+	if dir {
+		return os.MkdirAll(m.Target, 0o755)
+	}
+	if err := os.MkdirAll(filepath.Dir(m.Target), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(m.Target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// checkBeneath returns an error if the closest existing ancestor of path,
+// with its symlinks resolved, is not beneath workDir.
+func checkBeneath(workDir, path string) error {
+	root, err := filepath.EvalSymlinks(workDir)
+	if err != nil {
+		return err
+	}
+	for {
+		if _, err := os.Lstat(path); err == nil {
+			break
+		}
+		path = filepath.Dir(path)
+	}
+	real, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return err
+	}
+	if !within(root, real) {
+		return fmt.Errorf("%s resolves outside of the working directory", path)
+	}
+	return nil
+}
+
+// unmountAll detaches every mount of rc in reverse order. Errors are ignored,
+// since the mounts are removed along with the mount namespace anyway.
+func unmountAll(rc RuntimeConfig) {
+	for i := len(rc.Mounts) - 1; i >= 0; i-- {
+		_ = unix.Unmount(rc.Mounts[i].Target, unix.MNT_DETACH)
+	}
+}
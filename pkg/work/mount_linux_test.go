@@ -0,0 +1,76 @@
+//go:build linux
+// +build linux
+
+package work
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func Test_openBindSource(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	allowed := filepath.Join(dir, "datasets")
+	for _, d := range []string{filepath.Join(allowed, "images"), filepath.Join(allowed, "swapped"), filepath.Join(dir, "secrets", "images")} {
+		if err := os.MkdirAll(d, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// a source that was checked when the Job was started, then swapped for a symlink out of allowed
+	swapped := filepath.Join(allowed, "swapped")
+	if err := os.Remove(swapped); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(dir, "secrets"), swapped); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr func(error) bool
+	}{
+		{
+			name:    "allowed",
+			path:    allowed,
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "beneath allowed",
+			path:    filepath.Join(allowed, "images"),
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "swapped for a symlink",
+			path:    swapped,
+			wantErr: utils.ErrorTextContains(t, "failed to open bind source"),
+		},
+		{
+			name:    "parent swapped for a symlink",
+			path:    filepath.Join(swapped, "images"),
+			wantErr: utils.ErrorTextContains(t, "failed to open bind source"),
+		},
+		{
+			name:    "not allowed",
+			path:    filepath.Join(dir, "secrets"),
+			wantErr: utils.ErrorTextContains(t, "is not allowed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f, err := openBindSource(tt.path, []string{allowed})
+			if !tt.wantErr(err) {
+				t.Fatalf("openBindSource() error = %v", err)
+			}
+			if err == nil {
+				f.Close()
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package work
+
+import (
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestParseMount(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		s       string
+		want    Mount
+		wantErr func(error) bool
+	}{
+		{
+			s:       "type=bind,source=/srv/data,target=/data,readonly",
+			want:    Mount{Type: MountBind, Source: "/srv/data", Target: "/data", ReadOnly: true},
+			wantErr: utils.NoError(t),
+		},
+		{
+			s:       "type=bind,src=/srv/data,dst=data,ro=false",
+			want:    Mount{Type: MountBind, Source: "/srv/data", Target: "data"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			s:       "type=tmpfs,target=scratch,tmpfs-size=1048576",
+			want:    Mount{Type: MountTmpfs, Target: "scratch", Size: 1 << 20},
+			wantErr: utils.NoError(t),
+		},
+		{s: "type=volume,target=/data", wantErr: utils.ErrorTextContains(t, "must be bind or tmpfs")},
+		{s: "type=bind,source=/srv,target=/data,uid=0", wantErr: utils.ErrorTextContains(t, "unknown option 'uid'")},
+		{s: "type=bind,source=srv,target=/data", wantErr: utils.ErrorTextContains(t, "must be absolute and clean")},
+		{s: "type=bind,source=/srv,target=../data", wantErr: utils.ErrorTextContains(t, "relative to the working directory")},
+		{s: "type=bind,source=/srv,target=/", wantErr: utils.ErrorTextContains(t, "other than /")},
+		{s: "type=bind,source=/srv,target=/data,tmpfs-size=1", wantErr: utils.ErrorTextContains(t, "only valid for tmpfs")},
+		{s: "type=tmpfs,source=/srv,target=/data", wantErr: utils.ErrorTextContains(t, "only valid for bind")},
+		{s: "type=tmpfs,target=/data,readonly", wantErr: utils.ErrorTextContains(t, "cannot be read-only")},
+		{s: "type=tmpfs,target=/data,tmpfs-size=1G", wantErr: utils.ErrorTextContains(t, "invalid tmpfs-size")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParseMount(tt.s)
+			if !tt.wantErr(err) {
+				t.Fatalf("ParseMount() error = %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseMount() = %+v, want %+v", got, tt.want)
+			}
+			// formatting is parsed back into the same mount
+			if again, err := ParseMount(got.String()); err != nil || again != got {
+				t.Errorf("ParseMount(%q) = %+v, %v, want %+v", got.String(), again, err, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package work
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"syscall"
+
+	"github.com/drrev/telehandler/pkg/spec"
+)
+
+// namespaceFlags maps the type of every namespace that can be created for a [Job] to its clone flag.
+// Every namespace is created along with the reexec process, except the cgroup namespace, which is
+// created along with the command once it is in the cgroup of the Job, so the cgroup is its root.
+var namespaceFlags = map[string]uintptr{
+	spec.NamespaceCgroup:  syscall.CLONE_NEWCGROUP,
+	spec.NamespaceIPC:     syscall.CLONE_NEWIPC,
+	spec.NamespaceMount:   syscall.CLONE_NEWNS,
+	spec.NamespaceNetwork: syscall.CLONE_NEWNET,
+	spec.NamespacePID:     syscall.CLONE_NEWPID,
+	spec.NamespaceTime:    syscall.CLONE_NEWTIME,
+	spec.NamespaceUser:    syscall.CLONE_NEWUSER,
+	spec.NamespaceUTS:     syscall.CLONE_NEWUTS,
+}
+
+// RequiredNamespaces are created for every [Job], since its sandbox is built on them.
+var RequiredNamespaces = []string{spec.NamespaceMount, spec.NamespacePID, spec.NamespaceUser}
+
+// DefaultNamespaces are created for a [Job] unless changed by [Settings.Namespaces], or [Job.Namespaces].
+// The time namespace is not, since the clocks of a Job cannot be offset, nor is the network
+// namespace, which would leave Jobs with only a loopback interface that is down.
+var DefaultNamespaces = []string{
+	spec.NamespaceCgroup,
+	spec.NamespaceIPC,
+	spec.NamespaceMount,
+	spec.NamespacePID,
+	spec.NamespaceUser,
+	spec.NamespaceUTS,
+}
+
+// DefaultHostname is the hostname of a [Job] with a UTS namespace, unless changed by [Job.Hostname].
+const DefaultHostname = "sandbox"
+
+// ValidateNamespaces checks that namespaces lists every one of [RequiredNamespaces],
+// and no type of namespace that is unsupported, or listed more than once.
+// [ErrInvalidJob] is returned otherwise.
+func ValidateNamespaces(namespaces []string) error {
+	for i, ns := range namespaces {
+		if _, ok := namespaceFlags[ns]; !ok {
+			return invalidJob(fmt.Sprintf("namespace '%s' is not supported", ns))
+		}
+		if slices.Contains(namespaces[:i], ns) {
+			return invalidJob(fmt.Sprintf("namespace %s is listed more than once", ns))
+		}
+	}
+	for _, ns := range RequiredNamespaces {
+		if !slices.Contains(namespaces, ns) {
+			return invalidJob(fmt.Sprintf("namespace %s is required", ns))
+		}
+	}
+	return nil
+}
+
+// checkDropped checks that namespaces lists every one of defaults, except those in droppable.
+// [ErrInvalidJob] is returned otherwise.
+func checkDropped(namespaces, defaults, droppable []string) error {
+	for _, ns := range defaults {
+		if !slices.Contains(namespaces, ns) && !slices.Contains(droppable, ns) {
+			return invalidJob(fmt.Sprintf("namespace %s cannot be dropped", ns))
+		}
+	}
+	return nil
+}
+
+// cloneflags returns the clone flags of the namespaces created along with the reexec process.
+func cloneflags(namespaces []string) uintptr {
+	var flags uintptr
+	for _, ns := range namespaces {
+		if ns != spec.NamespaceCgroup {
+			flags |= namespaceFlags[ns]
+		}
+	}
+	return flags
+}
+
+// validateHostname checks that hostname is a valid hostname of at most 64 bytes,
+// made of dot separated labels of letters, digits, and hyphens, as in RFC 1123.
+func validateHostname(hostname string) error {
+	valid := len(hostname) <= 64
+	for _, label := range strings.Split(hostname, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			valid = false
+			break
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				valid = false
+			}
+		}
+	}
+	if !valid {
+		return invalidJob(fmt.Sprintf("hostname '%s' must be at most 64 characters of dot separated letters, digits, and hyphens", hostname))
+	}
+	return nil
+}
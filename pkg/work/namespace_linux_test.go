@@ -0,0 +1,17 @@
+package work
+
+import (
+	"syscall"
+	"testing"
+)
+
+func Test_cloneflags(t *testing.T) {
+	t.Parallel()
+	namespaces := []string{"mount", "pid", "user", "cgroup", "ipc", "time", "uts"}
+
+	// the cgroup namespace is created along with the command, not the reexec process
+	want := uintptr(syscall.CLONE_NEWNS | syscall.CLONE_NEWPID | syscall.CLONE_NEWUSER | syscall.CLONE_NEWIPC | syscall.CLONE_NEWTIME | syscall.CLONE_NEWUTS)
+	if got := cloneflags(namespaces); got != want {
+		t.Errorf("cloneflags() = %#x, want %#x", got, want)
+	}
+}
@@ -0,0 +1,21 @@
+package work
+
+// ChangeKind describes how a file in an overlay differs from the host.
+type ChangeKind string
+
+const (
+	// ChangeAdded is a file that does not exist on the host.
+	ChangeAdded ChangeKind = "added"
+	// ChangeModified is a file that exists on the host, but was written to, or had
+	// its metadata changed, by the Job. Parents of changed files are also modified.
+	ChangeModified ChangeKind = "modified"
+	// ChangeDeleted is a file on the host that was removed by the Job.
+	ChangeDeleted ChangeKind = "deleted"
+)
+
+// Change is a single file changed by a [Job] in one of its overlays, see [Job.Overlays].
+type Change struct {
+	// Path of the file as seen by the Job.
+	Path string
+	Kind ChangeKind
+}
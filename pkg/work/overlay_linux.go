@@ -0,0 +1,209 @@
+package work
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// Whiteouts of the OCI image layer format, which mark deleted files and opaque directories.
+// See: https://github.com/opencontainers/image-spec/blob/main/layer.md#whiteouts
+const (
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = whiteoutPrefix + whiteoutPrefix + ".opq"
+)
+
+// opaqueXattr is set on directories in an upper layer that replace the directory on the host.
+// The overlay is mounted with userxattr, since trusted xattrs need privileges on the host.
+const opaqueXattr = "user.overlay.opaque"
+
+// overlayLayers returns the upper and work directories of the i-th overlay in dir.
+func overlayLayers(dir string, i int) (upper string, work string) {
+	layer := filepath.Join(dir, strconv.Itoa(i))
+	return filepath.Join(layer, "upper"), filepath.Join(layer, "work")
+}
+
+// createOverlays creates empty upper and work directories in dir for every overlay.
+// The root of the overlay takes the mode of the upper directory, so it is copied from the host.
+func createOverlays(dir string, overlays []string) error {
+	for i, target := range overlays {
+		fi, err := os.Stat(target)
+		if err != nil {
+			return fmt.Errorf("failed to create overlay: %w", err)
+		}
+		upper, work := overlayLayers(dir, i)
+		for _, p := range []string{upper, work} {
+			if err := os.MkdirAll(p, 0o700); err != nil {
+				return fmt.Errorf("failed to create overlay: %w", err)
+			}
+		}
+		if err := os.Chmod(upper, fi.Mode()&(fs.ModePerm|fs.ModeSticky|fs.ModeSetgid)); err != nil {
+			return fmt.Errorf("failed to create overlay: %w", err)
+		}
+	}
+	return nil
+}
+
+// mountOverlays mounts an overlay over every overlaid directory of rc, within the mount
+// namespace of the reexec process. The directory on the host is the lower layer, so it is
+// never written to, and every change is written to the upper layer in rc.OverlayDir.
+func mountOverlays(rc RuntimeConfig) error {
+	for i, target := range rc.Overlays {
+		upper, work := overlayLayers(rc.OverlayDir, i)
+		data := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s,userxattr", target, upper, work)
+		if err := unix.Mount("overlay", target, "overlay", unix.MS_NOSUID|unix.MS_NODEV, data); err != nil {
+			return fmt.Errorf("failed to mount overlay on %s: %w", target, err)
+		}
+	}
+	return nil
+}
+
+// isWhiteout returns true if info is a whiteout in an upper layer, which marks a deleted file.
+func isWhiteout(info fs.FileInfo) bool {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	return ok && info.Mode()&fs.ModeCharDevice != 0 && st.Rdev == 0
+}
+
+// isOpaque returns true if the directory p in an upper layer replaces the directory on the host.
+func isOpaque(p string) bool {
+	buf := make([]byte, 1)
+	n, err := unix.Lgetxattr(p, opaqueXattr, buf)
+	return err == nil && n == 1 && buf[0] == 'y'
+}
+
+// walkUpper calls fn for every file in the upper layer of the overlay on target,
+// with the path of the file as seen by the Job, in lexical order.
+func walkUpper(upper string, target string, fn func(p string, jobPath string, info fs.FileInfo) error) error {
+	return filepath.WalkDir(upper, func(p string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && p == upper {
+			return filepath.SkipDir
+		}
+		if err != nil || p == upper {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(upper, p)
+		if err != nil {
+			return err
+		}
+		return fn(p, filepath.Join(target, rel), info)
+	})
+}
+
+// diffOverlays lists every file changed in the overlays in dir, compared to the host as it is now.
+func diffOverlays(dir string, overlays []string) ([]Change, error) {
+	var changes []Change
+	for i, target := range overlays {
+		upper, _ := overlayLayers(dir, i)
+		err := walkUpper(upper, target, func(p string, jobPath string, info fs.FileInfo) error {
+			if isWhiteout(info) {
+				changes = append(changes, Change{Path: jobPath, Kind: ChangeDeleted})
+				return nil
+			}
+
+			kind := ChangeAdded
+			if _, err := os.Lstat(jobPath); err == nil {
+				kind = ChangeModified
+			}
+			changes = append(changes, Change{Path: jobPath, Kind: kind})
+
+			if info.IsDir() && kind == ChangeModified && isOpaque(p) {
+				// everything on the host that was not recreated in the directory was deleted
+				entries, err := os.ReadDir(jobPath)
+				if err != nil {
+					return err
+				}
+				for _, e := range entries {
+					if _, err := os.Lstat(filepath.Join(p, e.Name())); errors.Is(err, fs.ErrNotExist) {
+						changes = append(changes, Change{Path: filepath.Join(jobPath, e.Name()), Kind: ChangeDeleted})
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return changes, fmt.Errorf("failed to diff overlay %s: %w", target, err)
+		}
+	}
+	return changes, nil
+}
+
+// writeOverlays writes the upper layers of the overlays in dir to w as a tar archive
+// in the OCI image layer format, with paths relative to the root of the Job.
+// Deleted files and opaque directories are written as whiteouts.
+func writeOverlays(dir string, overlays []string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+
+	for i, target := range overlays {
+		upper, _ := overlayLayers(dir, i)
+		err := walkUpper(upper, target, func(p string, jobPath string, info fs.FileInfo) error {
+			name := strings.TrimPrefix(filepath.ToSlash(jobPath), "/")
+			if info.Mode()&fs.ModeSocket != 0 {
+				// sockets cannot be archived, and are useless once the Job exits
+				return nil
+			}
+			if isWhiteout(info) {
+				return tw.WriteHeader(&tar.Header{
+					Typeflag: tar.TypeReg,
+					Name:     path.Join(path.Dir(name), whiteoutPrefix+path.Base(name)),
+					ModTime:  info.ModTime(),
+				})
+			}
+
+			var link string
+			if info.Mode()&fs.ModeSymlink != 0 {
+				var err error
+				if link, err = os.Readlink(p); err != nil {
+					return err
+				}
+			}
+			hdr, err := tar.FileInfoHeader(info, link)
+			if err != nil {
+				return err
+			}
+			hdr.Name = name
+			if info.IsDir() {
+				hdr.Name += "/"
+			}
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+
+			switch {
+			case info.IsDir() && isOpaque(p):
+				return tw.WriteHeader(&tar.Header{
+					Typeflag: tar.TypeReg,
+					Name:     path.Join(name, whiteoutOpaque),
+					ModTime:  info.ModTime(),
+				})
+			case info.Mode().IsRegular():
+				f, err := os.Open(p)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				_, err = io.Copy(tw, f)
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to archive overlay %s: %w", target, err)
+		}
+	}
+
+	return tw.Close()
+}
@@ -0,0 +1,124 @@
+package work
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// newUpperLayer creates the layers of an overlay on a new host directory as left behind
+// by a Job that modified, added, and deleted files, and replaced a directory.
+func newUpperLayer(t *testing.T) (dir string, host string) {
+	t.Helper()
+	host = t.TempDir()
+	dir = t.TempDir()
+	upper, _ := overlayLayers(dir, 0)
+
+	for p, data := range map[string]string{
+		"host/etc/hosts":      "127.0.0.1 localhost\n",
+		"host/etc/passwd":     "root:x:0:0::/root:/bin/sh\n",
+		"host/cache/a":        "a",
+		"host/cache/b":        "b",
+		"upper/etc/hosts":     "10.0.0.1 db\n",
+		"upper/etc/new.conf":  "new\n",
+		"upper/cache/c":       "c",
+		"upper/added/file":    "added",
+		"upper/added/another": "added",
+	} {
+		root, rel, _ := strings.Cut(p, "/")
+		full := filepath.Join(map[string]string{"host": host, "upper": upper}[root], rel)
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := unix.Mknod(filepath.Join(upper, "etc", "passwd"), unix.S_IFCHR, 0); err != nil {
+		t.Skipf("cannot create whiteout: %v", err)
+	}
+	if err := unix.Lsetxattr(filepath.Join(upper, "cache"), opaqueXattr, []byte("y"), 0); err != nil {
+		t.Skipf("cannot set opaque xattr: %v", err)
+	}
+	return dir, host
+}
+
+func Test_diffOverlays(t *testing.T) {
+	t.Parallel()
+	dir, host := newUpperLayer(t)
+
+	got, err := diffOverlays(dir, []string{host})
+	if err != nil {
+		t.Fatalf("diffOverlays() error = %v", err)
+	}
+	want := []Change{
+		{Path: filepath.Join(host, "added"), Kind: ChangeAdded},
+		{Path: filepath.Join(host, "added/another"), Kind: ChangeAdded},
+		{Path: filepath.Join(host, "added/file"), Kind: ChangeAdded},
+		{Path: filepath.Join(host, "cache"), Kind: ChangeModified},
+		{Path: filepath.Join(host, "cache/a"), Kind: ChangeDeleted},
+		{Path: filepath.Join(host, "cache/b"), Kind: ChangeDeleted},
+		{Path: filepath.Join(host, "cache/c"), Kind: ChangeAdded},
+		{Path: filepath.Join(host, "etc"), Kind: ChangeModified},
+		{Path: filepath.Join(host, "etc/hosts"), Kind: ChangeModified},
+		{Path: filepath.Join(host, "etc/new.conf"), Kind: ChangeAdded},
+		{Path: filepath.Join(host, "etc/passwd"), Kind: ChangeDeleted},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffOverlays() = %v, want %v", got, want)
+	}
+
+	if got, err := diffOverlays(t.TempDir(), []string{host}); err != nil || len(got) != 0 {
+		t.Errorf("diffOverlays() = %v, %v, want no changes for a missing upper layer", got, err)
+	}
+}
+
+func Test_writeOverlays(t *testing.T) {
+	t.Parallel()
+	dir, host := newUpperLayer(t)
+
+	var buf bytes.Buffer
+	if err := writeOverlays(dir, []string{host}, &buf); err != nil {
+		t.Fatalf("writeOverlays() error = %v", err)
+	}
+
+	got := make(map[string]string)
+	tr := tar.NewReader(&buf)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(tr)
+		got[hdr.Name] = string(data)
+	}
+
+	prefix := host[1:]
+	want := map[string]string{
+		prefix + "/added/":             "",
+		prefix + "/added/another":      "added",
+		prefix + "/added/file":         "added",
+		prefix + "/cache/":             "",
+		prefix + "/cache/.wh..wh..opq": "",
+		prefix + "/cache/c":            "c",
+		prefix + "/etc/":               "",
+		prefix + "/etc/hosts":          "10.0.0.1 db\n",
+		prefix + "/etc/new.conf":       "new\n",
+		prefix + "/etc/.wh.passwd":     "",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("writeOverlays() = %v, want %v", got, want)
+	}
+}
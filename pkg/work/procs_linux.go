@@ -0,0 +1,164 @@
+package work
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/cgroup2"
+)
+
+// clockTicks is the unit of CPU times in /proc/<pid>/stat.
+// The kernel always reports these in USER_HZ, which is 100 on every supported architecture.
+const clockTicks = 100
+
+// ProcessInfo is a snapshot of a single process in the sandbox of a [Running] or [Paused] [Job].
+type ProcessInfo struct {
+	// PID of the process on the host.
+	PID int
+	// NamespacePID is the PID of the process in the PID namespace of the Job.
+	NamespacePID int
+	// ParentPID is the PID of the parent of the process on the host.
+	ParentPID int
+	// Args is the command line of the process, or the command name in brackets
+	// if the process has no command line, such as a zombie.
+	Args []string
+	// State is the single character state of the process, as shown by ps, such as R or S.
+	State string
+	// RSS is the resident set size of the process in bytes.
+	RSS int64
+	// CPUTime is the total user and system CPU time used by the process.
+	CPUTime time.Duration
+}
+
+// Processes lists every process in the sandbox of the [Running] or [Paused] [Job] with the given name,
+// ordered by PID. This includes processes started with [Executor.Exec].
+//
+// An error is returned if the Job does not exist, or [ErrInvalidJobState] if it is not running or paused.
+func (m *Executor) Processes(name string) ([]ProcessInfo, error) {
+	m.mu.RLock()
+	ec, err := m.lookupContext(name)
+	m.mu.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if ec.jobSafe().State != Paused {
+		if _, err := ec.sandbox(); err != nil {
+			return nil, err
+		}
+	}
+
+	pids, err := cgroup2.Procs(filepath.Join(m.cgroot, ec.UID))
+	if err != nil {
+		return nil, err
+	}
+
+	procs := make([]ProcessInfo, 0, len(pids))
+	for _, pid := range pids {
+		p, err := readProcess("/proc", pid)
+		if errors.Is(err, fs.ErrNotExist) {
+			// the process exited after cgroup.procs was read
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		procs = append(procs, p)
+	}
+
+	slices.SortFunc(procs, func(a, b ProcessInfo) int { return a.PID - b.PID })
+	return procs, nil
+}
+
+// readProcess reads the [ProcessInfo] of pid from the procfs mounted at root.
+func readProcess(root string, pid int) (ProcessInfo, error) {
+	dir := filepath.Join(root, strconv.Itoa(pid))
+	p := ProcessInfo{PID: pid}
+
+	stat, err := os.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		return p, err
+	}
+	comm, err := parseStat(stat, &p)
+	if err != nil {
+		return p, fmt.Errorf("malformed stat of process %d: %w", pid, err)
+	}
+
+	status, err := os.ReadFile(filepath.Join(dir, "status"))
+	if err != nil {
+		return p, err
+	}
+	p.NamespacePID = parseNamespacePID(status, pid)
+
+	cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+	if err != nil {
+		return p, err
+	}
+	if cmdline = bytes.TrimRight(cmdline, "\x00"); len(cmdline) > 0 {
+		p.Args = strings.Split(string(cmdline), "\x00")
+	} else {
+		p.Args = []string{"[" + comm + "]"}
+	}
+
+	return p, nil
+}
+
+// parseStat fills p from the contents of /proc/<pid>/stat, returning the command name.
+// See proc_pid_stat(5) for the format.
+func parseStat(stat []byte, p *ProcessInfo) (comm string, err error) {
+	// the command name may contain spaces and parentheses, so split on the last parenthesis
+	start, end := bytes.IndexByte(stat, '('), bytes.LastIndexByte(stat, ')')
+	if start < 0 || end < start {
+		return "", errors.New("missing command name")
+	}
+	comm = string(stat[start+1 : end])
+
+	// fields following the command name, starting with state (3)
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 22 {
+		return "", fmt.Errorf("expected at least 24 fields, got %d", len(fields)+2)
+	}
+
+	num := func(i int) (n int64) {
+		if err == nil {
+			n, err = strconv.ParseInt(fields[i], 10, 64)
+		}
+		return
+	}
+
+	p.State = fields[0]
+	p.ParentPID = int(num(1))
+	ticks := num(11) + num(12)
+	p.CPUTime = time.Duration(ticks) * time.Second / clockTicks
+	p.RSS = num(21) * int64(os.Getpagesize())
+	return comm, err
+}
+
+// parseNamespacePID returns the PID of the process in its innermost PID namespace
+// from the contents of /proc/<pid>/status, or pid if it is not reported.
+func parseNamespacePID(status []byte, pid int) int {
+	for _, line := range strings.Split(string(status), "\n") {
+		ids, ok := strings.CutPrefix(line, "NSpid:")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(ids)
+		if len(fields) == 0 {
+			break
+		}
+		if n, err := strconv.Atoi(fields[len(fields)-1]); err == nil {
+			return n
+		}
+		break
+	}
+	return pid
+}
@@ -0,0 +1,100 @@
+package work
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExecutor_Processes(t *testing.T) {
+	t.Parallel()
+	m := &Executor{
+		mu:       sync.RWMutex{},
+		cgroot:   "/tmp",
+		contexts: make(map[string]*execContext),
+		startCmd: func(c *exec.Cmd, fn func(exitCode int)) error {
+			fn(0)
+			return nil
+		},
+	}
+
+	if _, err := m.Start(Job{Name: "a", UID: "a", Cmd: "true"}); err != nil {
+		t.Fatalf("Executor.Start() error = %v", err)
+	}
+
+	var notFound *ErrJobNotFound
+	if _, err := m.Processes("missing"); !errors.As(err, &notFound) {
+		t.Errorf("Executor.Processes() error = %v, want ErrJobNotFound", err)
+	}
+	var state *ErrInvalidJobState
+	if _, err := m.Processes("a"); !errors.As(err, &state) {
+		t.Errorf("Executor.Processes() error = %v, want ErrInvalidJobState for a completed job", err)
+	}
+}
+
+func Test_readProcess(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+
+	write := func(pid, name, data string) {
+		dir := filepath.Join(root, pid)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// comm contains a space and a parenthesis, and cpu times total 250 ticks
+	write("42", "stat", "42 (my (app)) S 7 42 42 0 -1 4194560 100 0 0 0 200 50 0 0 20 0 1 0 1000 1000000 3 18446744073709551615\n")
+	write("42", "status", "Name:\tmy (app)\nNSpid:\t42\t2\nVmRSS:\t12 kB\n")
+	write("42", "cmdline", "sleep\x0060\x00")
+
+	write("43", "stat", "43 (defunct) Z 42 42 42 0 -1 0 0 0 0 0 0 0 0 0 20 0 1 0 1000 0 0 0\n")
+	write("43", "status", "Name:\tdefunct\n")
+	write("43", "cmdline", "")
+
+	write("44", "stat", "44 sh S\n")
+	write("44", "status", "")
+	write("44", "cmdline", "")
+
+	got, err := readProcess(root, 42)
+	if err != nil {
+		t.Fatalf("readProcess() error = %v", err)
+	}
+	want := ProcessInfo{
+		PID:          42,
+		NamespacePID: 2,
+		ParentPID:    7,
+		Args:         []string{"sleep", "60"},
+		State:        "S",
+		RSS:          3 * int64(os.Getpagesize()),
+		CPUTime:      2500 * time.Millisecond,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readProcess() = %+v, want %+v", got, want)
+	}
+
+	got, err = readProcess(root, 43)
+	if err != nil {
+		t.Fatalf("readProcess() error = %v", err)
+	}
+	if got.State != "Z" || got.NamespacePID != 43 || !slices.Equal(got.Args, []string{"[defunct]"}) {
+		t.Errorf("readProcess() = %+v, want a zombie named [defunct] without a namespace PID", got)
+	}
+
+	if _, err := readProcess(root, 44); err == nil {
+		t.Errorf("readProcess() should error on a malformed stat")
+	}
+	if _, err := readProcess(root, 45); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("readProcess() error = %v, want fs.ErrNotExist for an exited process", err)
+	}
+}
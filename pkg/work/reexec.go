@@ -3,76 +3,245 @@ package work
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"os/exec"
+	"os/signal"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"syscall"
 
 	"github.com/drrev/telehandler/pkg/cgroup2"
+	"github.com/drrev/telehandler/pkg/landlock"
+	"github.com/drrev/telehandler/pkg/seccomp"
+	"github.com/drrev/telehandler/pkg/spec"
+	"golang.org/x/sys/unix"
 )
 
+// RuntimeConfig configures the sandbox created by [Reexec] for a single [Attempt].
+// It is passed to the reexec process as a [spec.Spec], see [RuntimeConfig.spec].
+type RuntimeConfig struct {
+	// Args is the command, and its args.
+	Args []string
+	// Env lists every KEY=VALUE environment variable of the command.
+	Env []string
+	// CgroupRoot is the path of the cgroup created for the Job.
+	CgroupRoot string
+	// Limits constrain the resources of the cgroup.
+	Limits Limits
+	// Namespaces lists the types of the namespaces created for the sandbox, see [spec.NamespacePID].
+	Namespaces []string
+	// Hostname of the sandbox. Only set if Namespaces includes a UTS namespace.
+	Hostname string
+	// WorkDir is the private working directory of the Job.
+	// All sibling directories are hidden from the Job.
+	WorkDir string
+	// Seccomp filters the system calls of the command, unless nil.
+	Seccomp *seccomp.Profile
+	// Capabilities is the capability bounding set of the command.
+	// Every other capability is dropped, so an empty set drops them all.
+	Capabilities []string
+	// User is the uid, and gid, that the command runs as in the user namespace.
+	// The reexec process already runs as User.
+	User int
+	// Rlimits are set before the command is started.
+	Rlimits []Rlimit
+	// Landlock restricts the host paths the command can access, unless empty.
+	Landlock landlock.Ruleset
+	// Mounts are performed in order once the working directory is isolated.
+	// Relative targets must already be joined with WorkDir.
+	Mounts []Mount
+	// BindSources are the host paths that the source of every bind mount must still be
+	// beneath once it is opened to be mounted, see [Settings.BindSources].
+	BindSources []string
+	// Overlays are host directories mounted as overlays, with their upper layers in OverlayDir.
+	Overlays   []string
+	OverlayDir string
+	// Layers are the lower layers of the root filesystem of the command, topmost first,
+	// with its upper layer in RootfsDir. The command runs in the host root if empty.
+	Layers    []string
+	RootfsDir string
+	// Cwd is the working directory of the command. Defaults to WorkDir.
+	Cwd string
+}
+
+// resources converts the Limits of c into cgroup constraints.
+func (c RuntimeConfig) resources() cgroup2.Resources {
+	return cgroup2.Resources{CPUMillis: c.Limits.CPU, MemoryBytes: c.Limits.Memory}
+}
+
 // Reexec is used to run a Linux command in a subprocess wrapper. This must not be called
 // by anything other than the reexec command.
-func Reexec(ctx context.Context, cgroupRoot string, args []string) (err error) {
+//
+// The sandbox and command are read from the [spec.Spec] passed by the Executor.
+// Once the sandbox is set up and the command is started, the PID of the command is sent to
+// the Executor over the control socket. If either fails, the error is sent instead of being
+// written to the output of the Job, then returned. Once the command is started, the control
+// socket stays open until the Executor closes it, so more processes can be started in the
+// sandbox, see [Executor.Exec].
+//
+// The wrapper is PID 1 of the PID namespace of the Job, so it acts as a minimal init
+// until the command exits: it reaps every orphaned descendant and forwards all signals
+// to the process group of the command. Returns the exit code of the command, or
+// 128 plus the signal number if it was killed by a signal.
+func Reexec(ctx context.Context) (exitCode int, err error) {
+	// the command must not inherit the control socket
+	syscall.CloseOnExec(controlFD)
+	control := os.NewFile(controlFD, "control")
+	defer control.Close()
+
+	defer func() {
+		if err != nil {
+			reportSetup(control, setupResult{Error: err.Error()})
+		}
+	}()
+
 	// Lock the OS thread to ensure that the currently executing thread does not die prematurely before this function returns.
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	if err := setupRuntime(cgroupRoot); err != nil {
-		return fmt.Errorf("setup runtime failed: %w", err)
+	rc, err := readSpec()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read runtime spec: %w", err)
+	}
+
+	if err := setupRuntime(rc); err != nil {
+		return 0, fmt.Errorf("setup runtime failed: %w", err)
 	}
-	defer teardownRuntime(cgroupRoot)
-	cancel := context.AfterFunc(ctx, func() {
-		teardownRuntime(cgroupRoot)
-	})
-	defer cancel()
+	defer teardownRuntime(rc)
 
 	// get the file descriptor for cgroupRoot
 	// then set it in SysProcAttr to take advantage
 	// of CLONE_INTO_CGROUP.
 	// see: https://man.archlinux.org/man/core/man-pages/clone.2.en#CLONE_INTO_CGROUP
-	fp, err := os.Open(cgroupRoot)
+	fp, err := os.Open(rc.CgroupRoot)
 	if err != nil {
-		return fmt.Errorf("failed to open cgroup")
+		return 0, fmt.Errorf("failed to open cgroup: %w", err)
 	}
 	defer fp.Close()
 
-	// Rebind all, ensure Pdeathsig to kill child on parent death.
-	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	if len(rc.Layers) > 0 {
+		if err := pivotRoot(rc); err != nil {
+			return 0, err
+		}
+	}
+
+	// Rebind all. The command is killed along with the PID namespace once the init exits.
+	cmd := rc.command(rc.Args, rc.Env, fp)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	cmd.Env = os.Environ()
+	// the command leads its own process group, so signals reach every process it starts
+	cmd.SysProcAttr.Setpgid = true
+
+	// relay every signal, including SIGCHLD, before any child can exit
+	sigs := make(chan os.Signal, 32)
+	signal.Notify(sigs)
+	defer signal.Stop(sigs)
+
+	if err := startRestricted(cmd, rc); err != nil {
+		return 0, fmt.Errorf("failed to start command: %w", err)
+	}
+	reportSetup(control, setupResult{PID: cmd.Process.Pid})
+
+	// the control socket stays open until the attempt exits, so the Executor
+	// can have the init start more processes in the sandbox, see [Executor.Exec]
+	procs := newExecProcs()
+	if conn, err := net.FileConn(control); err == nil {
+		go serveExec(conn.(*net.UnixConn), rc, fp, procs)
+	}
+	control.Close()
+
+	// the command is reaped by the init, not by cmd.Wait
+	return runInit(ctx, cmd.Process.Pid, sigs, procs), nil
+}
+
+// command creates a command with the given args and env, in the working directory of c,
+// that is started into the cgroup open as cgroup, and into its own cgroup namespace
+// if c has one. The command is looked up, and runs, within the root of c.
+func (c RuntimeConfig) command(args, env []string, cgroup *os.File) *exec.Cmd {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = env
+	cmd.Dir = c.WorkDir
+	if c.Cwd != "" {
+		cmd.Dir = c.Cwd
+	}
 
 	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Pdeathsig:   syscall.SIGTERM,
 		UseCgroupFD: true,
-		CgroupFD:    int(fp.Fd()),
+		CgroupFD:    int(cgroup.Fd()),
 	}
-	cmd.Cancel = func() error {
-		return cmd.Process.Signal(syscall.SIGTERM)
+	if slices.Contains(c.Namespaces, spec.NamespaceCgroup) {
+		// created once the command is in its cgroup, which becomes the root of the namespace
+		cmd.SysProcAttr.Cloneflags = syscall.CLONE_NEWCGROUP
 	}
+	return cmd
+}
+
+// startRestricted starts cmd from a dedicated OS thread that sets the rlimits of rc,
+// drops every capability not in its bounding set, sets no_new_privs, enforces its Landlock
+// rules, then loads its seccomp profile, if any, right before exec. The command inherits
+// the restrictions, but the init does not, except for rlimits, which apply to the whole
+// process. The init only reaps and forwards signals, so it is not affected by them.
+//
+// The thread is never unlocked, so the runtime discards it once cmd is started.
+// The command must not use Pdeathsig, since the thread is its parent.
+func startRestricted(cmd *exec.Cmd, rc RuntimeConfig) error {
+	errCh := make(chan error)
+	go func() {
+		runtime.LockOSThread()
 
-	return cmd.Run()
+		// set once the thread exists, so RLIMIT_NPROC cannot keep it from being created
+		if err := setRlimits(rc.Rlimits); err != nil {
+			errCh <- err
+			return
+		}
+		if err := dropCapabilities(rc.Capabilities); err != nil {
+			errCh <- err
+			return
+		}
+		// see: https://docs.kernel.org/userspace-api/no_new_privs.html
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			errCh <- fmt.Errorf("failed to set no_new_privs: %w", err)
+			return
+		}
+		if !rc.Landlock.Empty() {
+			if err := landlock.Restrict(rc.Landlock); err != nil {
+				errCh <- err
+				return
+			}
+		}
+		if rc.Seccomp != nil {
+			if err := seccomp.Load(*rc.Seccomp); err != nil {
+				errCh <- err
+				return
+			}
+		}
+		errCh <- cmd.Start()
+	}()
+	return <-errCh
 }
 
 // setupRuntime is a convenience function to setup
 // cgroups and perform any other setup BEFORE the child
 // process is spawned.
-func setupRuntime(cgroupRoot string) (err error) {
+func setupRuntime(rc RuntimeConfig) (err error) {
 	defer func() {
 		if err != nil {
 			// eagerly teardown if any setup failed
-			teardownRuntime(cgroupRoot)
+			teardownRuntime(rc)
 		}
 	}()
 
-	if err := cgroup2.Create(cgroupRoot); err != nil {
+	if err := cgroup2.Create(rc.CgroupRoot, rc.resources()); err != nil {
 		return fmt.Errorf("failed to create cgroup: %w", err)
 	}
 
-	if err := syscall.Sethostname([]byte("sandbox")); err != nil {
-		return fmt.Errorf("failed to set hostname: %w", err)
+	if slices.Contains(rc.Namespaces, spec.NamespaceUTS) {
+		if err := syscall.Sethostname([]byte(rc.Hostname)); err != nil {
+			return fmt.Errorf("failed to set hostname: %w", err)
+		}
 	}
 
 	// Since we are not moving the rootfs, we MUST mount recursively (MS_REC) /proc
@@ -87,19 +256,79 @@ func setupRuntime(cgroupRoot string) (err error) {
 		return fmt.Errorf("failed to mount over /proc: %w", err)
 	}
 
+	if len(rc.Layers) > 0 {
+		// the host root, including every other working directory, is detached by pivotRoot
+		if err := mountRootfs(rc); err != nil {
+			return err
+		}
+		rooted, err := rc.rooted()
+		if err != nil {
+			return err
+		}
+		return mountAll(rooted)
+	}
+
+	// the upper layers are hidden along with the siblings of the working directory
+	if err := mountOverlays(rc); err != nil {
+		return err
+	}
+
+	if rc.WorkDir != "" {
+		if err := isolateWorkDir(rc.WorkDir); err != nil {
+			return fmt.Errorf("failed to isolate working directory: %w", err)
+		}
+	}
+
+	if err := mountAll(rc); err != nil {
+		return err
+	}
+
 	return
 }
 
+// isolateWorkDir hides the working directories of all other Jobs by mounting
+// an empty tmpfs over the parent of workDir, then binds workDir back into place.
+// Mounts are private to the mount namespace, so the host is not affected.
+func isolateWorkDir(workDir string) error {
+	// hold the directory open, so it can be bound after it is hidden
+	fd, err := syscall.Open(workDir, syscall.O_RDONLY|syscall.O_DIRECTORY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer syscall.Close(fd)
+
+	root := filepath.Dir(workDir)
+	if err := syscall.Mount("tmpfs", root, "tmpfs", uintptr(syscall.MS_NOSUID|syscall.MS_NODEV|syscall.MS_NOEXEC), "mode=0755,size=64k"); err != nil {
+		return fmt.Errorf("failed to hide %s: %w", root, err)
+	}
+	if err := os.Mkdir(workDir, 0o700); err != nil {
+		return err
+	}
+
+	src := fmt.Sprintf("/proc/self/fd/%d", fd)
+	if err := syscall.Mount(src, workDir, "", uintptr(syscall.MS_BIND), ""); err != nil {
+		return fmt.Errorf("failed to bind %s: %w", workDir, err)
+	}
+	return nil
+}
+
 // teardownRuntime cleans up from setupRuntime
 // AFTER the child process terminates.
 //
+// Once the root is pivoted, host paths are unreachable, so the cgroup
+// is removed by the Executor instead.
+//
 // IMPORTANT: If the parent receives SIGKILL, or SIGSTOP,
 // this function will not be executed, as those
 // signals cannot be trapped: https://pkg.go.dev/os/signal#hdr-Types_of_signals.
-func teardownRuntime(cgroupRoot string) {
+func teardownRuntime(rc RuntimeConfig) {
 	// errors are intentionally ignored, we do not want to
 	// log into the wrapped command's output
 	// and we cannot do anything about these errors
+	unmountAll(rc)
+	for i := len(rc.Overlays) - 1; i >= 0; i-- {
+		_ = syscall.Unmount(rc.Overlays[i], syscall.MNT_DETACH)
+	}
 	_ = syscall.Unmount("/proc", 0)
-	_ = cgroup2.Cleanup(cgroupRoot)
+	_ = cgroup2.Cleanup(rc.CgroupRoot)
 }
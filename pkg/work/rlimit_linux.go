@@ -0,0 +1,61 @@
+package work
+
+import (
+	"fmt"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// rlimits maps the name of every resource limit to its resource.
+var rlimits = map[string]int{
+	"RLIMIT_AS":         unix.RLIMIT_AS,
+	"RLIMIT_CORE":       unix.RLIMIT_CORE,
+	"RLIMIT_CPU":        unix.RLIMIT_CPU,
+	"RLIMIT_DATA":       unix.RLIMIT_DATA,
+	"RLIMIT_FSIZE":      unix.RLIMIT_FSIZE,
+	"RLIMIT_LOCKS":      unix.RLIMIT_LOCKS,
+	"RLIMIT_MEMLOCK":    unix.RLIMIT_MEMLOCK,
+	"RLIMIT_MSGQUEUE":   unix.RLIMIT_MSGQUEUE,
+	"RLIMIT_NICE":       unix.RLIMIT_NICE,
+	"RLIMIT_NOFILE":     unix.RLIMIT_NOFILE,
+	"RLIMIT_NPROC":      unix.RLIMIT_NPROC,
+	"RLIMIT_RSS":        unix.RLIMIT_RSS,
+	"RLIMIT_RTPRIO":     unix.RLIMIT_RTPRIO,
+	"RLIMIT_RTTIME":     unix.RLIMIT_RTTIME,
+	"RLIMIT_SIGPENDING": unix.RLIMIT_SIGPENDING,
+	"RLIMIT_STACK":      unix.RLIMIT_STACK,
+}
+
+// validateRlimits checks that every limit names a known resource once, and that
+// its soft limit does not exceed its hard limit.
+func validateRlimits(limits []Rlimit) error {
+	seen := make(map[string]bool, len(limits))
+	for _, l := range limits {
+		if _, ok := rlimits[l.Type]; !ok {
+			return invalidJob(fmt.Sprintf("unknown rlimit '%s'", l.Type))
+		}
+		if seen[l.Type] {
+			return invalidJob(fmt.Sprintf("rlimit %s is set more than once", l.Type))
+		}
+		seen[l.Type] = true
+		if l.Soft > l.Hard {
+			return invalidJob(fmt.Sprintf("rlimit %s soft limit must not exceed its hard limit", l.Type))
+		}
+	}
+	return nil
+}
+
+// setRlimits sets every limit on the calling process. A hard limit can only be raised
+// with CAP_SYS_RESOURCE in the initial user namespace, so it fails in a sandbox.
+//
+// syscall.Setrlimit is used, so the Go runtime does not restore its original
+// RLIMIT_NOFILE in the command.
+func setRlimits(limits []Rlimit) error {
+	for _, l := range limits {
+		if err := syscall.Setrlimit(rlimits[l.Type], &syscall.Rlimit{Cur: l.Soft, Max: l.Hard}); err != nil {
+			return fmt.Errorf("failed to set %s: %w", l.Type, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package work
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// devices are bound from the host into the /dev of a root filesystem.
+var devices = []string{"null", "zero", "full", "random", "urandom", "tty"}
+
+// rootfsDirs returns the upper, work, and merged directories of the root filesystem in dir.
+func rootfsDirs(dir string) (upper string, work string, merged string) {
+	return filepath.Join(dir, "upper"), filepath.Join(dir, "work"), filepath.Join(dir, "merged")
+}
+
+// createRootfs creates empty upper, work, and merged directories in dir.
+func createRootfs(dir string) error {
+	upper, work, merged := rootfsDirs(dir)
+	for _, p := range []string{upper, work, merged} {
+		if err := os.MkdirAll(p, 0o755); err != nil {
+			return fmt.Errorf("failed to create root filesystem: %w", err)
+		}
+	}
+	return nil
+}
+
+// mountRootfs mounts the layers of rc as an overlay on the merged directory, within the
+// mount namespace of the reexec process, so changes are written to the upper directory.
+// The overlay gets a minimal /dev, a new /proc, and the working directory at the same path.
+// Nothing is visible to the command until [pivotRoot].
+func mountRootfs(rc RuntimeConfig) error {
+	upper, work, merged := rootfsDirs(rc.RootfsDir)
+	data := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s,userxattr", strings.Join(rc.Layers, ":"), upper, work)
+	if err := unix.Mount("overlay", merged, "overlay", unix.MS_NOSUID|unix.MS_NODEV, data); err != nil {
+		return fmt.Errorf("failed to mount root filesystem: %w", err)
+	}
+
+	dev, err := resolveInRoot(merged, "/dev", true)
+	if err != nil {
+		return fmt.Errorf("failed to create /dev: %w", err)
+	}
+	if err := unix.Mount("tmpfs", dev, "tmpfs", unix.MS_NOSUID|unix.MS_NOEXEC, "mode=0755,size=64k"); err != nil {
+		return fmt.Errorf("failed to mount /dev: %w", err)
+	}
+	for _, name := range devices {
+		p := filepath.Join(dev, name)
+		if err := os.WriteFile(p, nil, 0o666); err != nil {
+			return err
+		}
+		if err := unix.Mount(filepath.Join("/dev", name), p, "", unix.MS_BIND, ""); err != nil {
+			return fmt.Errorf("failed to bind /dev/%s: %w", name, err)
+		}
+	}
+	for name, target := range map[string]string{"fd": "/proc/self/fd", "stdin": "/proc/self/fd/0", "stdout": "/proc/self/fd/1", "stderr": "/proc/self/fd/2"} {
+		if err := os.Symlink(target, filepath.Join(dev, name)); err != nil {
+			return err
+		}
+	}
+
+	proc, err := resolveInRoot(merged, "/proc", true)
+	if err != nil {
+		return fmt.Errorf("failed to create /proc: %w", err)
+	}
+	if err := unix.Mount("proc", proc, "proc", unix.MS_NOSUID|unix.MS_NOEXEC|unix.MS_NODEV, ""); err != nil {
+		return fmt.Errorf("failed to mount /proc: %w", err)
+	}
+
+	if rc.WorkDir != "" {
+		workDir, err := resolveInRoot(merged, rc.WorkDir, true)
+		if err != nil {
+			return fmt.Errorf("failed to create working directory: %w", err)
+		}
+		if err := unix.Mount(rc.WorkDir, workDir, "", unix.MS_BIND, ""); err != nil {
+			return fmt.Errorf("failed to bind %s: %w", rc.WorkDir, err)
+		}
+	}
+	return nil
+}
+
+// rooted returns a copy of rc whose working directory and mount targets are
+// the paths that they resolve to within the merged root filesystem of rc.
+func (c RuntimeConfig) rooted() (RuntimeConfig, error) {
+	_, _, merged := rootfsDirs(c.RootfsDir)
+	rooted := c
+	rooted.Mounts = make([]Mount, len(c.Mounts))
+	if c.WorkDir != "" {
+		workDir, err := resolveInRoot(merged, c.WorkDir, false)
+		if err != nil {
+			return c, fmt.Errorf("failed to resolve working directory: %w", err)
+		}
+		rooted.WorkDir = workDir
+	}
+	for i, m := range c.Mounts {
+		if rel, err := filepath.Rel(c.WorkDir, m.Target); c.WorkDir != "" && err == nil && filepath.IsLocal(rel) {
+			// created beneath the working directory, which is checked for symlinks
+			m.Target = filepath.Join(rooted.WorkDir, rel)
+		} else {
+			target, err := resolveInRoot(merged, m.Target, false)
+			if err != nil {
+				return c, fmt.Errorf("failed to resolve mount target %s: %w", m.Target, err)
+			}
+			m.Target = target
+		}
+		rooted.Mounts[i] = m
+	}
+	return rooted, nil
+}
+
+// resolveInRoot returns the host path of p, resolved as if root were the root directory,
+// so symlinks in the root filesystem cannot resolve to the host. Missing directories
+// are created if mkdir is true.
+func resolveInRoot(root string, p string, mkdir bool) (string, error) {
+	rfd, err := unix.Open(root, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return "", err
+	}
+	defer unix.Close(rfd)
+
+	how := &unix.OpenHow{Flags: unix.O_PATH | unix.O_CLOEXEC, Resolve: unix.RESOLVE_IN_ROOT | unix.RESOLVE_NO_MAGICLINKS}
+	fd, err := unix.Openat2(rfd, p, how)
+	if errors.Is(err, unix.ENOENT) && mkdir {
+		fd, err = mkdirInRoot(rfd, p, how)
+	}
+	if err != nil {
+		return "", err
+	}
+	defer unix.Close(fd)
+
+	return os.Readlink(fmt.Sprintf("/proc/self/fd/%d", fd))
+}
+
+// mkdirInRoot creates every missing directory of p, resolving each parent within the root rfd,
+// then opens p with how.
+func mkdirInRoot(rfd int, p string, how *unix.OpenHow) (int, error) {
+	fd, err := unix.Dup(rfd)
+	if err != nil {
+		return -1, err
+	}
+	prefix := ""
+	for _, elem := range strings.Split(strings.Trim(filepath.Clean(p), "/"), "/") {
+		err := unix.Mkdirat(fd, elem, 0o755)
+		unix.Close(fd)
+		if err != nil && !errors.Is(err, unix.EEXIST) {
+			return -1, err
+		}
+		prefix = filepath.Join(prefix, elem)
+		if fd, err = unix.Openat2(rfd, prefix, how); err != nil {
+			return -1, err
+		}
+	}
+	return fd, nil
+}
+
+// pivotRoot makes the merged root filesystem of rc the root of the mount namespace
+// of the reexec process, then detaches the host root, so no host path is reachable.
+func pivotRoot(rc RuntimeConfig) error {
+	_, _, merged := rootfsDirs(rc.RootfsDir)
+	if err := unix.Chdir(merged); err != nil {
+		return err
+	}
+	// stacks the host root on top of the new root, so it can be detached from "."
+	// see: https://man7.org/linux/man-pages/man2/pivot_root.2.html#NOTES
+	if err := unix.PivotRoot(".", "."); err != nil {
+		return fmt.Errorf("failed to pivot root: %w", err)
+	}
+	if err := unix.Unmount(".", unix.MNT_DETACH); err != nil {
+		return fmt.Errorf("failed to detach host root: %w", err)
+	}
+	return unix.Chdir("/")
+}
@@ -0,0 +1,167 @@
+package work
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+)
+
+// DefaultMemoryReservation is the number of bytes each [Job] reserves
+// against [Capacity.Memory] if [Limits.Memory] is not set. This matches
+// the default memory.max constraint applied to every Job cgroup.
+const DefaultMemoryReservation int64 = 512 << 20
+
+// ErrExceedsCapacity is returned if a [Job] requires more resources
+// than the [Executor] could ever provide.
+var ErrExceedsCapacity = errors.New("job exceeds executor capacity")
+
+// Capacity is the total amount of resources available to running Jobs.
+// A zero value for any field means that resource is unlimited.
+type Capacity struct {
+	// Slots is the maximum number of concurrently running Jobs.
+	Slots int
+	// Memory is the maximum number of bytes reserved by running Jobs.
+	Memory int64
+}
+
+// ticket is a request to run a single [Job] within a [Capacity].
+type ticket struct {
+	ec       *execContext
+	name     string
+	owner    string
+	priority int
+	memory   int64
+	seq      uint64
+}
+
+// newTicket creates a ticket for the given execContext. The Job fields
+// used for scheduling must not change after the ticket is created.
+func newTicket(ec *execContext) ticket {
+	return ticket{
+		ec:       ec,
+		name:     ec.Name,
+		owner:    ec.Owner,
+		priority: ec.Priority,
+		memory:   ec.Limits.memory(),
+	}
+}
+
+// scheduler admits Jobs to run within a fixed [Capacity].
+//
+// Jobs that do not fit are held in a queue ordered by priority,
+// then by the number of Jobs the owner is already running (fairness),
+// then by submission order. Only the head of the queue is ever admitted,
+// so a large Job cannot be starved by a stream of smaller ones.
+//
+// This type is NOT thread-safe.
+type scheduler struct {
+	capacity Capacity
+	slots    int
+	memory   int64
+	seq      uint64
+	owners   map[string]int
+	running  map[string]ticket
+	queue    []ticket
+}
+
+// newScheduler creates an empty scheduler for the given [Capacity].
+func newScheduler(c Capacity) *scheduler {
+	return &scheduler{
+		capacity: c,
+		owners:   make(map[string]int),
+		running:  make(map[string]ticket),
+	}
+}
+
+// submit adds t to the queue. [ErrExceedsCapacity] is returned
+// if t could never be admitted.
+func (s *scheduler) submit(t ticket) error {
+	if s.capacity.Memory > 0 && t.memory > s.capacity.Memory {
+		return ErrExceedsCapacity
+	}
+
+	s.seq++
+	t.seq = s.seq
+	s.queue = append(s.queue, t)
+	return nil
+}
+
+// drain admits queued tickets in order until the head of the queue
+// no longer fits. Capacity is reserved for all returned tickets and
+// must be given back with release.
+func (s *scheduler) drain() (admitted []ticket) {
+	for len(s.queue) > 0 {
+		s.sort()
+		t := s.queue[0]
+		if !s.fits(t) {
+			break
+		}
+
+		s.queue = s.queue[1:]
+		s.slots++
+		s.memory += t.memory
+		s.owners[t.owner]++
+		s.running[t.name] = t
+		admitted = append(admitted, t)
+	}
+	return
+}
+
+// release gives back capacity held by the running ticket with the given name.
+func (s *scheduler) release(name string) {
+	t, ok := s.running[name]
+	if !ok {
+		return
+	}
+
+	delete(s.running, name)
+	s.slots--
+	s.memory -= t.memory
+	s.owners[t.owner]--
+	if s.owners[t.owner] <= 0 {
+		delete(s.owners, t.owner)
+	}
+}
+
+// cancel removes a queued ticket. Returns false if no ticket
+// with the given name is queued.
+func (s *scheduler) cancel(name string) bool {
+	i := slices.IndexFunc(s.queue, func(t ticket) bool { return t.name == name })
+	if i < 0 {
+		return false
+	}
+	s.queue = slices.Delete(s.queue, i, i+1)
+	return true
+}
+
+// position returns the 1-based queue position for the ticket with the
+// given name, or 0 if it is not queued.
+func (s *scheduler) position(name string) int {
+	s.sort()
+	return slices.IndexFunc(s.queue, func(t ticket) bool { return t.name == name }) + 1
+}
+
+// fits checks if t can run within the remaining capacity.
+func (s *scheduler) fits(t ticket) bool {
+	if s.capacity.Slots > 0 && s.slots >= s.capacity.Slots {
+		return false
+	}
+	if s.capacity.Memory > 0 && s.memory+t.memory > s.capacity.Memory {
+		return false
+	}
+	return true
+}
+
+// sort orders the queue by priority, fairness, then submission order.
+// Fairness depends on running Jobs, so the order is recomputed on demand.
+func (s *scheduler) sort() {
+	slices.SortStableFunc(s.queue, func(a, b ticket) int {
+		if a.priority != b.priority {
+			return b.priority - a.priority
+		}
+		if ra, rb := s.owners[a.owner], s.owners[b.owner]; ra != rb {
+			return ra - rb
+		}
+		return cmp.Compare(a.seq, b.seq)
+	})
+}
@@ -0,0 +1,135 @@
+package work
+
+import (
+	"slices"
+	"testing"
+)
+
+func Test_scheduler_drain(t *testing.T) {
+	t.Parallel()
+	mk := func(name, owner string, priority int) ticket {
+		return ticket{name: name, owner: owner, priority: priority, memory: DefaultMemoryReservation}
+	}
+
+	tests := []struct {
+		name     string
+		capacity Capacity
+		running  []ticket
+		submit   []ticket
+		want     []string
+	}{
+		{
+			name:   "unlimited",
+			submit: []ticket{mk("a", "u1", 0), mk("b", "u1", 0), mk("c", "u2", 0)},
+			want:   []string{"a", "c", "b"},
+		},
+		{
+			name:     "slots",
+			capacity: Capacity{Slots: 2},
+			submit:   []ticket{mk("a", "u1", 0), mk("b", "u1", 0), mk("c", "u2", 0)},
+			want:     []string{"a", "c"},
+		},
+		{
+			name:     "memory",
+			capacity: Capacity{Memory: DefaultMemoryReservation},
+			submit:   []ticket{mk("a", "u1", 0), mk("b", "u1", 0)},
+			want:     []string{"a"},
+		},
+		{
+			name:     "priority",
+			capacity: Capacity{Slots: 1},
+			submit:   []ticket{mk("a", "u1", 0), mk("b", "u1", 10), mk("c", "u1", 5)},
+			want:     []string{"b"},
+		},
+		{
+			name:     "fairness",
+			capacity: Capacity{Slots: 2},
+			running:  []ticket{mk("r", "u1", 0)},
+			submit:   []ticket{mk("a", "u1", 0), mk("b", "u2", 0)},
+			want:     []string{"b"},
+		},
+		{
+			name:     "head of line blocks",
+			capacity: Capacity{Memory: 2 * DefaultMemoryReservation},
+			running:  []ticket{mk("r", "u1", 0)},
+			submit: []ticket{
+				{name: "big", owner: "u1", priority: 1, memory: 2 * DefaultMemoryReservation},
+				mk("small", "u1", 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newScheduler(tt.capacity)
+			for _, r := range tt.running {
+				if err := s.submit(r); err != nil {
+					t.Fatalf("scheduler.submit() error = %v", err)
+				}
+			}
+			s.drain()
+
+			for _, tk := range tt.submit {
+				if err := s.submit(tk); err != nil {
+					t.Fatalf("scheduler.submit() error = %v", err)
+				}
+			}
+
+			var got []string
+			for _, tk := range s.drain() {
+				got = append(got, tk.name)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("scheduler.drain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_scheduler_release(t *testing.T) {
+	t.Parallel()
+	s := newScheduler(Capacity{Slots: 1})
+
+	for _, name := range []string{"a", "b", "c"} {
+		if err := s.submit(ticket{name: name, owner: "u1"}); err != nil {
+			t.Fatalf("scheduler.submit() error = %v", err)
+		}
+	}
+
+	if got := s.drain(); len(got) != 1 || got[0].name != "a" {
+		t.Fatalf("scheduler.drain() = %v, want [a]", got)
+	}
+
+	if got := s.position("c"); got != 2 {
+		t.Errorf("scheduler.position() = %v, want 2", got)
+	}
+
+	if !s.cancel("b") {
+		t.Error("scheduler.cancel() expected queued ticket to be removed")
+	}
+	if s.cancel("a") {
+		t.Error("scheduler.cancel() removed a running ticket")
+	}
+
+	if got := s.position("c"); got != 1 {
+		t.Errorf("scheduler.position() = %v, want 1", got)
+	}
+
+	s.release("a")
+	if got := s.drain(); len(got) != 1 || got[0].name != "c" {
+		t.Fatalf("scheduler.drain() = %v, want [c]", got)
+	}
+
+	if got := s.position("c"); got != 0 {
+		t.Errorf("scheduler.position() = %v, want 0", got)
+	}
+}
+
+func Test_scheduler_submit(t *testing.T) {
+	t.Parallel()
+	s := newScheduler(Capacity{Memory: DefaultMemoryReservation - 1})
+
+	if err := s.submit(ticket{memory: DefaultMemoryReservation}); err != ErrExceedsCapacity {
+		t.Errorf("scheduler.submit() error = %v, want %v", err, ErrExceedsCapacity)
+	}
+}
@@ -0,0 +1,164 @@
+package work
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+)
+
+const (
+	// DefaultMaxMatches is the number of matches returned by a [Search] if MaxMatches is zero.
+	DefaultMaxMatches = 1000
+	// MaxContextLines is the maximum number of context lines before or after each match.
+	MaxContextLines = 100
+	// maxLineLength is the longest line that is matched as a whole.
+	// Longer lines are split, as if a newline was written every maxLineLength bytes.
+	maxLineLength = 64 << 10
+)
+
+// Search describes a search for lines in the output of a [Job].
+type Search struct {
+	// Pattern is a regular expression in RE2 syntax, or a literal string if Literal is set.
+	Pattern string
+	// Literal matches Pattern as a plain string.
+	Literal bool
+	// IgnoreCase matches Pattern without regard to case.
+	IgnoreCase bool
+	// Before is the number of context lines returned before each match.
+	Before int
+	// After is the number of context lines returned after each match.
+	After int
+	// MaxMatches stops the search once this many matches are found.
+	// Defaults to [DefaultMaxMatches].
+	MaxMatches int
+}
+
+// compile validates the Search and returns the regular expression for Pattern.
+func (s Search) compile() (*regexp.Regexp, error) {
+	if s.Pattern == "" {
+		return nil, invalidSearch("pattern must not be empty")
+	}
+	if s.Before < 0 || s.After < 0 || s.Before > MaxContextLines || s.After > MaxContextLines {
+		return nil, invalidSearch(fmt.Sprintf("context lines must be between 0 and %d", MaxContextLines))
+	}
+	if s.MaxMatches < 0 {
+		return nil, invalidSearch("max matches must not be negative")
+	}
+
+	expr := s.Pattern
+	if s.Literal {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if s.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, invalidSearch(err.Error())
+	}
+	return re, nil
+}
+
+// Line is a single line of [Job] output.
+type Line struct {
+	// Offset of the first byte of the line in the Job output.
+	Offset int64
+	// Number is the 1-based line number.
+	Number int64
+	// Data of the line, without the trailing newline.
+	Data []byte
+}
+
+// Match is a [Line] that matched a [Search], with its context lines.
+type Match struct {
+	Line
+	// Before holds up to [Search.Before] lines preceding the match, oldest first.
+	Before []Line
+	// After holds up to [Search.After] lines following the match.
+	After []Line
+}
+
+// searchLines calls fn with every line of r that matches re, in order,
+// until r is drained, s.MaxMatches are found, or ctx is done.
+func searchLines(ctx context.Context, r io.Reader, re *regexp.Regexp, s Search, fn func(Match) error) error {
+	limit := s.MaxMatches
+	if limit == 0 {
+		limit = DefaultMaxMatches
+	}
+
+	var (
+		br      = bufio.NewReaderSize(r, maxLineLength)
+		before  = make([]Line, 0, s.Before)
+		pending []Match
+		found   int
+		offset  int64
+		number  int64
+	)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// stop reading once every match has its context
+		if found == limit && len(pending) == 0 {
+			return nil
+		}
+
+		data, err := br.ReadSlice('\n')
+		if len(data) > 0 {
+			number++
+			line := Line{Offset: offset, Number: number, Data: bytes.Clone(bytes.TrimSuffix(data, []byte("\n")))}
+			offset += int64(len(data))
+
+			// complete the context of earlier matches
+			for i := range pending {
+				pending[i].After = append(pending[i].After, line)
+			}
+			for len(pending) > 0 && len(pending[0].After) == s.After {
+				if err := fn(pending[0]); err != nil {
+					return err
+				}
+				pending = pending[1:]
+			}
+
+			if found < limit && re.Match(line.Data) {
+				found++
+				m := Match{Line: line, Before: slices.Clone(before)}
+				if s.After == 0 {
+					if err := fn(m); err != nil {
+						return err
+					}
+				} else {
+					pending = append(pending, m)
+				}
+			}
+
+			if s.Before > 0 {
+				if len(before) == s.Before {
+					before = append(before[:0], before[1:]...)
+				}
+				before = append(before, line)
+			}
+		}
+
+		switch {
+		case err == nil, errors.Is(err, bufio.ErrBufferFull):
+		case errors.Is(err, io.EOF):
+			// the output ended before the context of some matches was complete
+			for _, m := range pending {
+				if err := fn(m); err != nil {
+					return err
+				}
+			}
+			return nil
+		default:
+			return err
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package work
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func Test_searchLines(t *testing.T) {
+	t.Parallel()
+	const output = "start\nwarn: disk\nok\nerror: disk full\nok\nERROR: retry\nend"
+
+	// format matches as "number:line" with context lines as "number-line"
+	format := func(ms []Match) string {
+		var out []string
+		for _, m := range ms {
+			for _, l := range m.Before {
+				out = append(out, strings.Join([]string{strconv.FormatInt(l.Number, 10), string(l.Data)}, "-"))
+			}
+			out = append(out, strings.Join([]string{strconv.FormatInt(m.Number, 10), string(m.Data)}, ":"))
+			for _, l := range m.After {
+				out = append(out, strings.Join([]string{strconv.FormatInt(l.Number, 10), string(l.Data)}, "-"))
+			}
+		}
+		return strings.Join(out, " ")
+	}
+
+	tests := []struct {
+		name    string
+		s       Search
+		want    string
+		wantErr func(error) bool
+	}{
+		{name: "regex", s: Search{Pattern: "^error|warn"}, want: "2:warn: disk 4:error: disk full", wantErr: utils.NoError(t)},
+		{name: "ignore case", s: Search{Pattern: "error", IgnoreCase: true}, want: "4:error: disk full 6:ERROR: retry", wantErr: utils.NoError(t)},
+		{name: "literal", s: Search{Pattern: "warn:", Literal: true}, want: "2:warn: disk", wantErr: utils.NoError(t)},
+		{name: "literal metacharacters", s: Search{Pattern: "^e", Literal: true}, want: "", wantErr: utils.NoError(t)},
+		{name: "context", s: Search{Pattern: "full", Before: 2, After: 1}, want: "2-warn: disk 3-ok 4:error: disk full 5-ok", wantErr: utils.NoError(t)},
+		{name: "context at edges", s: Search{Pattern: "start|end", Before: 1, After: 1}, want: "1:start 2-warn: disk 6-ERROR: retry 7:end", wantErr: utils.NoError(t)},
+		{name: "max matches", s: Search{Pattern: "ok", MaxMatches: 1, After: 1}, want: "3:ok 4-error: disk full", wantErr: utils.NoError(t)},
+		{name: "empty pattern", s: Search{}, wantErr: utils.ErrorTextContains(t, "pattern must not be empty")},
+		{name: "malformed", s: Search{Pattern: "("}, wantErr: utils.ErrorTextContains(t, "invalid search")},
+		{name: "too much context", s: Search{Pattern: "ok", Before: MaxContextLines + 1}, wantErr: utils.ErrorTextContains(t, "context lines")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Match
+			re, err := tt.s.compile()
+			if err == nil {
+				err = searchLines(context.Background(), strings.NewReader(output), re, tt.s, func(m Match) error {
+					got = append(got, m)
+					return nil
+				})
+			}
+			if !tt.wantErr(err) {
+				t.Fatalf("searchLines() error = %v", err)
+			}
+			if s := format(got); s != tt.want {
+				t.Errorf("searchLines() = %q, want %q", s, tt.want)
+			}
+		})
+	}
+}
+
+func Test_searchLines_offsets(t *testing.T) {
+	t.Parallel()
+	s := Search{Pattern: "b"}
+	re, _ := s.compile()
+
+	var offsets []int64
+	err := searchLines(context.Background(), strings.NewReader("a\nbb\nccc\nb"), re, s, func(m Match) error {
+		offsets = append(offsets, m.Offset)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("searchLines() error = %v", err)
+	}
+	if len(offsets) != 2 || offsets[0] != 2 || offsets[1] != 9 {
+		t.Errorf("searchLines() offsets = %v, want [2 9]", offsets)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = searchLines(ctx, strings.NewReader("b"), re, s, func(Match) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("searchLines() error = %v, want %v", err, context.Canceled)
+	}
+}
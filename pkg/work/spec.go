@@ -0,0 +1,313 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/drrev/telehandler/pkg/spec"
+)
+
+// Annotations of the [spec.Spec] passed to [Reexec], which carry the settings
+// of a [RuntimeConfig] that have no equivalent in the runtime specification.
+// Lists are encoded as JSON arrays.
+const (
+	annotationWorkDir          = "telehandler.workdir"
+	annotationOverlays         = "telehandler.overlays"
+	annotationOverlayDir       = "telehandler.overlay-dir"
+	annotationLayers           = "telehandler.layers"
+	annotationRootfsDir        = "telehandler.rootfs-dir"
+	annotationLandlockReadOnly = "telehandler.landlock.read-only"
+	annotationLandlockRW       = "telehandler.landlock.read-write"
+	annotationBindSources      = "telehandler.bind-sources"
+)
+
+// cpuPeriod is the period, in microseconds, of the CPU quota of a [spec.Spec].
+const cpuPeriod = 100000
+
+// spec converts c into the runtime spec passed to [Reexec].
+func (c RuntimeConfig) spec() spec.Spec {
+	s := spec.Spec{
+		Version: spec.Version,
+		Process: &spec.Process{
+			User:            spec.User{UID: uint32(c.User), GID: uint32(c.User)},
+			Args:            c.Args,
+			Env:             c.Env,
+			Cwd:             c.Cwd,
+			Capabilities:    &spec.Capabilities{Bounding: c.Capabilities},
+			NoNewPrivileges: true,
+		},
+		Hostname:    c.Hostname,
+		Annotations: make(map[string]string),
+		Linux: &spec.Linux{
+			Resources:   &spec.Resources{},
+			CgroupsPath: c.CgroupRoot,
+			Seccomp:     c.Seccomp,
+		},
+	}
+	for _, l := range c.Rlimits {
+		s.Process.Rlimits = append(s.Process.Rlimits, spec.Rlimit{Type: l.Type, Soft: l.Soft, Hard: l.Hard})
+	}
+	for _, m := range c.Mounts {
+		s.Mounts = append(s.Mounts, m.spec())
+	}
+	for _, ns := range c.Namespaces {
+		s.Linux.Namespaces = append(s.Linux.Namespaces, spec.Namespace{Type: ns})
+	}
+	if c.Limits.CPU > 0 {
+		quota, period := c.Limits.CPU*cpuPeriod/1000, uint64(cpuPeriod)
+		s.Linux.Resources.CPU = &spec.CPU{Quota: &quota, Period: &period}
+	}
+	if c.Limits.Memory > 0 {
+		s.Linux.Resources.Memory = &spec.Memory{Limit: &c.Limits.Memory}
+	}
+
+	annotate := func(key string, value string) {
+		if value != "" {
+			s.Annotations[key] = value
+		}
+	}
+	annotateList := func(key string, values []string) {
+		if len(values) > 0 {
+			data, _ := json.Marshal(values)
+			s.Annotations[key] = string(data)
+		}
+	}
+	annotate(annotationWorkDir, c.WorkDir)
+	annotateList(annotationOverlays, c.Overlays)
+	annotate(annotationOverlayDir, c.OverlayDir)
+	annotateList(annotationLayers, c.Layers)
+	annotate(annotationRootfsDir, c.RootfsDir)
+	annotateList(annotationLandlockReadOnly, c.Landlock.ReadOnly)
+	annotateList(annotationLandlockRW, c.Landlock.ReadWrite)
+	annotateList(annotationBindSources, c.BindSources)
+	return s
+}
+
+// runtimeConfig converts the runtime spec written by [RuntimeConfig.spec] back into a RuntimeConfig.
+func runtimeConfig(s spec.Spec) (RuntimeConfig, error) {
+	c := RuntimeConfig{
+		Args:     s.Process.Args,
+		Env:      s.Process.Env,
+		Cwd:      s.Process.Cwd,
+		User:     int(s.Process.User.UID),
+		Hostname: s.Hostname,
+	}
+	if s.Process.Capabilities != nil {
+		c.Capabilities = s.Process.Capabilities.Bounding
+	}
+	for _, l := range s.Process.Rlimits {
+		c.Rlimits = append(c.Rlimits, Rlimit{Type: l.Type, Soft: l.Soft, Hard: l.Hard})
+	}
+	for _, sm := range s.Mounts {
+		m, err := mountFromSpec(sm)
+		if err != nil {
+			return c, err
+		}
+		c.Mounts = append(c.Mounts, m)
+	}
+	if s.Linux != nil {
+		c.CgroupRoot = s.Linux.CgroupsPath
+		c.Seccomp = s.Linux.Seccomp
+		for _, ns := range s.Linux.Namespaces {
+			c.Namespaces = append(c.Namespaces, ns.Type)
+		}
+		limits, err := limitsFromSpec(s.Linux.Resources)
+		if err != nil {
+			return c, err
+		}
+		c.Limits = limits
+	}
+
+	c.WorkDir = s.Annotations[annotationWorkDir]
+	c.OverlayDir = s.Annotations[annotationOverlayDir]
+	c.RootfsDir = s.Annotations[annotationRootfsDir]
+	for key, list := range map[string]*[]string{
+		annotationOverlays:         &c.Overlays,
+		annotationLayers:           &c.Layers,
+		annotationLandlockReadOnly: &c.Landlock.ReadOnly,
+		annotationLandlockRW:       &c.Landlock.ReadWrite,
+		annotationBindSources:      &c.BindSources,
+	} {
+		if value, ok := s.Annotations[key]; ok {
+			if err := json.Unmarshal([]byte(value), list); err != nil {
+				return c, fmt.Errorf("malformed annotation %s: %w", key, err)
+			}
+		}
+	}
+	return c, nil
+}
+
+// limitsFromSpec converts the cgroup resources of a runtime spec into [Limits].
+func limitsFromSpec(r *spec.Resources) (Limits, error) {
+	var l Limits
+	if r == nil {
+		return l, nil
+	}
+	if r.CPU != nil && r.CPU.Quota != nil {
+		if *r.CPU.Quota <= 0 || *r.CPU.Period == 0 {
+			return l, invalidJob("linux.resources.cpu quota and period must be positive")
+		}
+		l.CPU = max(*r.CPU.Quota*1000/int64(*r.CPU.Period), 1)
+	}
+	if r.Memory != nil && r.Memory.Limit != nil {
+		if *r.Memory.Limit <= 0 {
+			return l, invalidJob("linux.resources.memory.limit must be positive")
+		}
+		l.Memory = *r.Memory.Limit
+	}
+	return l, nil
+}
+
+// spec converts m into a mount of a runtime spec. Binds are always recursive.
+func (m Mount) spec() spec.Mount {
+	sm := spec.Mount{Destination: m.Target, Type: string(m.Type), Source: m.Source}
+	switch m.Type {
+	case MountBind:
+		sm.Options = []string{"rbind"}
+		if m.ReadOnly {
+			sm.Options = append(sm.Options, "ro")
+		}
+	case MountTmpfs:
+		sm.Source = "tmpfs"
+		if m.Size > 0 {
+			sm.Options = []string{"size=" + strconv.FormatInt(m.Size, 10)}
+		}
+	}
+	return sm
+}
+
+// mountFromSpec converts a mount of a runtime spec into a [Mount].
+// Only bind and tmpfs mounts are supported, with the options ro, rw, bind, rbind,
+// nosuid, and nodev, and size for tmpfs. Every mount is nosuid and nodev.
+func mountFromSpec(sm spec.Mount) (Mount, error) {
+	m := Mount{Type: MountType(sm.Type), Source: sm.Source, Target: sm.Destination}
+	if (sm.Type == "" || sm.Type == "none") && (slices.Contains(sm.Options, "bind") || slices.Contains(sm.Options, "rbind")) {
+		m.Type = MountBind
+	}
+	if m.Type == MountTmpfs && m.Source == "tmpfs" {
+		m.Source = ""
+	}
+
+	for _, opt := range sm.Options {
+		key, value, _ := strings.Cut(opt, "=")
+		switch {
+		case opt == "ro":
+			m.ReadOnly = true
+		case opt == "rw":
+			m.ReadOnly = false
+		case opt == "nosuid", opt == "nodev":
+		case (opt == "bind" || opt == "rbind") && m.Type == MountBind:
+		case key == "size" && m.Type == MountTmpfs:
+			size, err := parseSize(value)
+			if err != nil {
+				return m, invalidJob(fmt.Sprintf("mount %s has an invalid size '%s'", sm.Destination, value))
+			}
+			m.Size = size
+		default:
+			return m, invalidJob(fmt.Sprintf("mount option '%s' of %s is not supported", opt, sm.Destination))
+		}
+	}
+	return m, m.validate()
+}
+
+// parseSize parses a number of bytes with an optional k, m, or g suffix, as tmpfs does.
+func parseSize(s string) (int64, error) {
+	shift := 0
+	if s != "" {
+		switch s[len(s)-1] {
+		case 'k', 'K':
+			shift = 10
+		case 'm', 'M':
+			shift = 20
+		case 'g', 'G':
+			shift = 30
+		}
+	}
+	if shift > 0 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 || n > math.MaxInt64>>shift {
+		return 0, fmt.Errorf("invalid size '%s'", s)
+	}
+	return n << shift, nil
+}
+
+// ApplySpec configures j from the config.json of an OCI runtime bundle, see [spec.Parse].
+// The process, mounts, resources, hostname, and namespaces of s replace those of j, and
+// its annotations are added to those of j, unless already set. The bounding set of s
+// replaces the default capabilities. Without namespaces, j gets those of the Executor.
+//
+// Settings that every Job gets from the Executor are rejected with [ErrInvalidJob]
+// instead of being ignored: the cgroup path, and seccomp filter.
+func (j *Job) ApplySpec(s spec.Spec) error {
+	p := s.Process
+	switch {
+	case s.Linux != nil && s.Linux.CgroupsPath != "":
+		return invalidJob("linux.cgroupsPath is not supported, every job has its own cgroup")
+	case s.Linux != nil && s.Linux.Seccomp != nil:
+		return invalidJob("linux.seccomp is not supported, name a seccomp profile of the server instead")
+	case !p.NoNewPrivileges:
+		return invalidJob("process.noNewPrivileges must be true, it cannot be disabled")
+	case p.User.GID != p.User.UID:
+		return invalidJob("process.user.gid must equal process.user.uid")
+	}
+
+	caps := Capabilities{Drop: []string{"ALL"}}
+	if p.Capabilities != nil {
+		for name, set := range map[string][]string{"effective": p.Capabilities.Effective, "permitted": p.Capabilities.Permitted} {
+			for _, c := range set {
+				if !slices.Contains(p.Capabilities.Bounding, c) {
+					return invalidJob(fmt.Sprintf("process.capabilities.%s must be a subset of the bounding set, %s is not", name, c))
+				}
+			}
+		}
+		caps.Add = slices.Clone(p.Capabilities.Bounding)
+	}
+
+	rlimits := make([]Rlimit, 0, len(p.Rlimits))
+	for _, l := range p.Rlimits {
+		rlimits = append(rlimits, Rlimit{Type: l.Type, Soft: l.Soft, Hard: l.Hard})
+	}
+	mounts := make([]Mount, 0, len(s.Mounts))
+	for _, sm := range s.Mounts {
+		m, err := mountFromSpec(sm)
+		if err != nil {
+			return err
+		}
+		mounts = append(mounts, m)
+	}
+	var limits Limits
+	var namespaces []string
+	if s.Linux != nil {
+		var err error
+		if limits, err = limitsFromSpec(s.Linux.Resources); err != nil {
+			return err
+		}
+		for _, ns := range s.Linux.Namespaces {
+			namespaces = append(namespaces, ns.Type)
+		}
+	}
+
+	j.Cmd, j.Args = p.Args[0], slices.Clone(p.Args[1:])
+	j.Env = slices.Clone(p.Env)
+	j.Cwd = p.Cwd
+	j.RunAsUser = int(p.User.UID)
+	j.Capabilities = caps
+	j.Rlimits = rlimits
+	j.Mounts = mounts
+	j.Limits = limits
+	j.Namespaces = namespaces
+	j.Hostname = s.Hostname
+	if len(s.Annotations) > 0 {
+		annotations := maps.Clone(s.Annotations)
+		maps.Copy(annotations, j.Annotations)
+		j.Annotations = annotations
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package work
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/drrev/telehandler/pkg/spec"
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+func TestJob_ApplySpec(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		spec    string
+		job     Job
+		want    Job
+		wantErr func(error) bool
+	}{
+		{
+			name: "bundle",
+			spec: `{
+				"ociVersion": "1.0.2",
+				"process": {
+					"user": {"uid": 1000, "gid": 1000},
+					"args": ["make", "-j4"],
+					"env": ["PATH=/usr/bin:/bin"],
+					"cwd": "/src",
+					"capabilities": {"bounding": ["CAP_KILL", "CAP_CHOWN"], "effective": ["CAP_KILL"]},
+					"rlimits": [{"type": "RLIMIT_NOFILE", "hard": 1024, "soft": 512}],
+					"noNewPrivileges": true
+				},
+				"mounts": [
+					{"destination": "/data", "type": "bind", "source": "/srv/data", "options": ["rbind", "ro", "nosuid"]},
+					{"destination": "scratch", "type": "tmpfs", "source": "tmpfs", "options": ["size=64m"]}
+				],
+				"annotations": {"team": "infra", "owner": "bundle"},
+				"linux": {"resources": {"cpu": {"quota": 50000, "period": 100000}, "memory": {"limit": 268435456}}}
+			}`,
+			job: Job{Name: "a", Annotations: map[string]string{"owner": "request"}},
+			want: Job{
+				Name:         "a",
+				Cmd:          "make",
+				Args:         []string{"-j4"},
+				Env:          []string{"PATH=/usr/bin:/bin"},
+				Cwd:          "/src",
+				RunAsUser:    1000,
+				Capabilities: Capabilities{Drop: []string{"ALL"}, Add: []string{"CAP_KILL", "CAP_CHOWN"}},
+				Rlimits:      []Rlimit{{Type: "RLIMIT_NOFILE", Soft: 512, Hard: 1024}},
+				Mounts: []Mount{
+					{Type: MountBind, Source: "/srv/data", Target: "/data", ReadOnly: true},
+					{Type: MountTmpfs, Target: "scratch", Size: 64 << 20},
+				},
+				Limits:      Limits{CPU: 500, Memory: 256 << 20},
+				Annotations: map[string]string{"team": "infra", "owner": "request"},
+			},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "no capabilities",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "noNewPrivileges": true}}`,
+			want:    Job{Cmd: "true", Args: []string{}, Capabilities: Capabilities{Drop: []string{"ALL"}}, Rlimits: []Rlimit{}, Mounts: []Mount{}},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name: "hostname and namespaces",
+			spec: `{"ociVersion": "1.2.0", "hostname": "build", "process": {"args": ["true"], "noNewPrivileges": true}, "linux": {"namespaces": [{"type": "mount"}, {"type": "pid"}, {"type": "user"}, {"type": "uts"}]}}`,
+			want: Job{
+				Cmd:          "true",
+				Args:         []string{},
+				Capabilities: Capabilities{Drop: []string{"ALL"}},
+				Rlimits:      []Rlimit{},
+				Mounts:       []Mount{},
+				Namespaces:   []string{"mount", "pid", "user", "uts"},
+				Hostname:     "build",
+			},
+			wantErr: utils.NoError(t),
+		},
+		{
+			name:    "cgroups path",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "noNewPrivileges": true}, "linux": {"cgroupsPath": "/sys/fs/cgroup/other"}}`,
+			wantErr: utils.ErrorTextContains(t, "linux.cgroupsPath is not supported"),
+		},
+		{
+			name:    "seccomp",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "noNewPrivileges": true}, "linux": {"seccomp": {"defaultAction": "SCMP_ACT_ALLOW"}}}`,
+			wantErr: utils.ErrorTextContains(t, "linux.seccomp is not supported"),
+		},
+		{
+			name:    "new privileges",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"]}}`,
+			wantErr: utils.ErrorTextContains(t, "process.noNewPrivileges must be true"),
+		},
+		{
+			name:    "different gid",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "user": {"uid": 1000, "gid": 100}, "noNewPrivileges": true}}`,
+			wantErr: utils.ErrorTextContains(t, "process.user.gid must equal process.user.uid"),
+		},
+		{
+			name:    "effective outside bounding",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "capabilities": {"bounding": ["CAP_KILL"], "permitted": ["CAP_SYS_ADMIN"]}, "noNewPrivileges": true}}`,
+			wantErr: utils.ErrorTextContains(t, "process.capabilities.permitted must be a subset of the bounding set, CAP_SYS_ADMIN is not"),
+		},
+		{
+			name:    "unsupported mount type",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "noNewPrivileges": true}, "mounts": [{"destination": "/proc", "type": "proc", "source": "proc"}]}`,
+			wantErr: utils.ErrorTextContains(t, "mount type 'proc' must be bind or tmpfs"),
+		},
+		{
+			name:    "unsupported mount option",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "noNewPrivileges": true}, "mounts": [{"destination": "/dev", "type": "tmpfs", "options": ["mode=755"]}]}`,
+			wantErr: utils.ErrorTextContains(t, "mount option 'mode=755' of /dev is not supported"),
+		},
+		{
+			name:    "negative quota",
+			spec:    `{"ociVersion": "1.2.0", "process": {"args": ["true"], "noNewPrivileges": true}, "linux": {"resources": {"cpu": {"quota": -1, "period": 100000}}}}`,
+			wantErr: utils.ErrorTextContains(t, "quota and period must be positive"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, err := spec.Parse(strings.NewReader(tt.spec))
+			if err != nil {
+				t.Fatalf("spec.Parse() error = %v", err)
+			}
+
+			got := tt.job
+			err = got.ApplySpec(s)
+			if !tt.wantErr(err) {
+				t.Fatalf("Job.ApplySpec() error = %v", err)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Job.ApplySpec() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_mountFromSpec(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		mount   spec.Mount
+		want    Mount
+		wantErr func(error) bool
+	}{
+		{
+			mount:   spec.Mount{Destination: "/data", Source: "/srv/data", Options: []string{"bind", "ro"}},
+			want:    Mount{Type: MountBind, Source: "/srv/data", Target: "/data", ReadOnly: true},
+			wantErr: utils.NoError(t),
+		},
+		{
+			mount:   spec.Mount{Destination: "/data", Type: "none", Source: "/srv/data", Options: []string{"rbind", "ro", "rw"}},
+			want:    Mount{Type: MountBind, Source: "/srv/data", Target: "/data"},
+			wantErr: utils.NoError(t),
+		},
+		{
+			mount:   spec.Mount{Destination: "/tmp", Type: "tmpfs", Options: []string{"nosuid", "nodev", "size=65536k"}},
+			want:    Mount{Type: MountTmpfs, Target: "/tmp", Size: 64 << 20},
+			wantErr: utils.NoError(t),
+		},
+		{
+			mount:   spec.Mount{Destination: "/tmp", Type: "tmpfs", Options: []string{"size=1048576"}},
+			want:    Mount{Type: MountTmpfs, Target: "/tmp", Size: 1 << 20},
+			wantErr: utils.NoError(t),
+		},
+		{
+			mount:   spec.Mount{Destination: "/tmp", Type: "tmpfs", Options: []string{"size=1t"}},
+			wantErr: utils.ErrorTextContains(t, "invalid size '1t'"),
+		},
+		{
+			mount:   spec.Mount{Destination: "/data", Type: "bind", Source: "/srv", Options: []string{"size=1m"}},
+			wantErr: utils.ErrorTextContains(t, "mount option 'size=1m' of /data is not supported"),
+		},
+		{
+			mount:   spec.Mount{Destination: "/tmp", Type: "tmpfs", Options: []string{"rbind"}},
+			wantErr: utils.ErrorTextContains(t, "mount option 'rbind' of /tmp is not supported"),
+		},
+		{
+			mount:   spec.Mount{Destination: "/data", Source: "/srv"},
+			wantErr: utils.ErrorTextContains(t, "mount type '' must be bind or tmpfs"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mount.Destination, func(t *testing.T) {
+			got, err := mountFromSpec(tt.mount)
+			if !tt.wantErr(err) {
+				t.Fatalf("mountFromSpec() error = %v", err)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mountFromSpec() = %+v, want %+v", got, tt.want)
+			}
+			// the mounts passed to the reexec process are converted back unchanged
+			if err == nil {
+				if back, err := mountFromSpec(got.spec()); err != nil || back != got {
+					t.Errorf("Mount.spec() = %+v, %v, want %+v", back, err, got)
+				}
+			}
+		})
+	}
+}
@@ -24,7 +24,6 @@ func startCmd(c *exec.Cmd, done func(exitCode int)) error {
 
 		if err := c.Start(); err != nil {
 			errCh <- err
-			done(CannotExecute)
 			return
 		}
 		close(errCh)
@@ -0,0 +1,6 @@
+// Package workflow runs directed acyclic graphs (DAG) of [work.Job] steps.
+// Each step declares the steps it depends on and the [Condition] under which
+// it may run. Steps are started as soon as their dependencies terminate.
+//
+// All workflows are managed by the [Manager].
+package workflow
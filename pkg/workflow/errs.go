@@ -0,0 +1,48 @@
+package workflow
+
+import (
+	"fmt"
+)
+
+func invalidWorkflow(reason string) *ErrInvalidWorkflow {
+	return &ErrInvalidWorkflow{reason}
+}
+
+// ErrInvalidWorkflow is returned if a [Workflow] is malformed.
+type ErrInvalidWorkflow struct {
+	reason string
+}
+
+// Error implements error.
+func (e *ErrInvalidWorkflow) Error() string {
+	return fmt.Sprintf("invalid workflow: %s", e.reason)
+}
+
+func invalidState(s State) *ErrInvalidState {
+	return &ErrInvalidState{s}
+}
+
+// ErrInvalidState is returned when an operation is attempted against any Workflow
+// that is in the incorrect state.
+type ErrInvalidState struct {
+	state State
+}
+
+// Error implements error.
+func (e *ErrInvalidState) Error() string {
+	return fmt.Sprintf("invalid state '%s'", e.state)
+}
+
+func workflowNotFound(name string) *ErrNotFound {
+	return &ErrNotFound{name}
+}
+
+// ErrNotFound is returned if no workflow is found for a given name.
+type ErrNotFound struct {
+	name string
+}
+
+// Error implements error.
+func (e *ErrNotFound) Error() string {
+	return fmt.Sprintf("no workflow found with name='%v'", e.name)
+}
@@ -0,0 +1,334 @@
+package workflow
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/pkg/work"
+)
+
+// Executor is the minimal interface needed to run the Jobs of each [Step].
+type Executor interface {
+	Start(j work.Job) (work.Job, error)
+	Stop(name string) error
+	Lookup(name string) (work.Job, error)
+	Changes() <-chan struct{}
+}
+
+// Manager is a thread-safe [Workflow] manager.
+// Each Workflow is driven by a dedicated goroutine that starts
+// steps as their dependencies terminate.
+//
+// See [NewManager].
+type Manager struct {
+	mu    sync.RWMutex
+	exe   Executor
+	flows map[string]*flowContext
+}
+
+// NewManager creates an initialized [Manager] ready for use.
+func NewManager(exe Executor) *Manager {
+	return &Manager{
+		mu:    sync.RWMutex{},
+		exe:   exe,
+		flows: make(map[string]*flowContext),
+	}
+}
+
+// Create validates and starts the given [Workflow]. Steps with no
+// dependencies are started immediately.
+//
+// [ErrInvalidWorkflow] is returned if validation fails.
+func (m *Manager) Create(w Workflow) (Workflow, error) {
+	if err := w.Validate(); err != nil {
+		return w, err
+	}
+
+	w = w.clone()
+	w.State = Running
+	w.CreateTime = time.Now()
+	for i := range w.Steps {
+		w.Steps[i].Job = ""
+		w.Steps[i].State = Pending
+	}
+
+	fc := &flowContext{
+		Workflow: w,
+		stop:     make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	m.flows[w.Name] = fc
+	m.mu.Unlock()
+
+	slog.Info("Workflow created", slog.Any("workflow", w.LogValue()))
+
+	// advance synchronously so the caller sees the initial steps started
+	done := fc.advance(m.exe)
+	if !done {
+		go fc.run(m.exe)
+	}
+
+	return fc.workflowSafe(), nil
+}
+
+// Lookup returns a copy of any [Workflow] found. If no Workflow is found, a [ErrNotFound]
+// is returned and the Workflow value is zero.
+func (m *Manager) Lookup(name string) (Workflow, error) {
+	fc, err := m.lookupContext(name)
+	if err != nil {
+		return Workflow{}, err
+	}
+	return fc.workflowSafe(), nil
+}
+
+// Stop the [Workflow] with the given name. All queued and running steps
+// are stopped and all pending steps are skipped.
+//
+// [ErrInvalidState] is returned if the Workflow is not running.
+func (m *Manager) Stop(name string) error {
+	fc, err := m.lookupContext(name)
+	if err != nil {
+		return err
+	}
+	return fc.interrupt()
+}
+
+// Changes returns a channel that is closed the next time the
+// [Workflow] with the given name changes.
+func (m *Manager) Changes(name string) (<-chan struct{}, error) {
+	fc, err := m.lookupContext(name)
+	if err != nil {
+		return nil, err
+	}
+	return fc.changed.Wait(), nil
+}
+
+// lookupContext is a thread-safe method for finding a flowContext by name.
+func (m *Manager) lookupContext(name string) (*flowContext, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	fc, ok := m.flows[name]
+	if !ok {
+		return nil, workflowNotFound(name)
+	}
+	return fc, nil
+}
+
+// flowContext tracks the execution of a single [Workflow].
+type flowContext struct {
+	Workflow
+	m        sync.Mutex
+	stop     chan struct{}
+	once     sync.Once
+	stopping bool
+	stopSent bool
+	changed  safe.Notifier
+}
+
+// workflowSafe is a thread-safe accessor for Workflow.
+func (f *flowContext) workflowSafe() Workflow {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.Workflow.clone()
+}
+
+// interrupt requests the workflow to stop.
+// This operation is thread-safe.
+func (f *flowContext) interrupt() error {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if !f.Running() {
+		return invalidState(f.State)
+	}
+
+	f.once.Do(func() { close(f.stop) })
+	return nil
+}
+
+// run advances the workflow each time a Job changes state
+// until all steps terminate.
+func (f *flowContext) run(exe Executor) {
+	stop := f.stop
+	for {
+		// grab the channel before advancing, so no changes are missed
+		changes := exe.Changes()
+		if f.advance(exe) {
+			return
+		}
+
+		select {
+		case <-changes:
+		case <-stop:
+			stop = nil
+			f.m.Lock()
+			f.stopping = true
+			f.m.Unlock()
+		}
+	}
+}
+
+// advance refreshes the state of all started steps, then starts or skips
+// any pending steps whose dependencies terminated. Returns true once the
+// workflow has terminated.
+// This operation is thread-safe, but must not be called concurrently,
+// since the lock is released while steps are started.
+func (f *flowContext) advance(exe Executor) (done bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	changed := false
+	for i := range f.Steps {
+		s := &f.Steps[i]
+		if s.Job == "" || s.Terminal() {
+			continue
+		}
+
+		job, err := exe.Lookup(s.Job)
+		if err != nil {
+			continue
+		}
+		if st := stepStateOf(job.State); st != s.State {
+			s.State = st
+			changed = true
+		}
+	}
+
+	// skipped steps, and steps that fail to start, may cascade,
+	// so loop until nothing else changes
+	for progressed := true; progressed; {
+		progressed = false
+		var starts []int
+		for i := range f.Steps {
+			s := &f.Steps[i]
+			if s.State != Pending {
+				continue
+			}
+
+			ready, skip := f.evaluate(s)
+			switch {
+			case skip || f.stopping:
+				s.State = Skipped
+			case ready:
+				starts = append(starts, i)
+			default:
+				continue
+			}
+			progressed = true
+			changed = true
+		}
+		f.startSteps(exe, starts)
+	}
+
+	if f.stopping && !f.stopSent {
+		f.stopSent = true
+		for _, s := range f.Steps {
+			if s.Job != "" && !s.Terminal() {
+				if err := exe.Stop(s.Job); err != nil {
+					slog.Warn("Failed to stop workflow step", slog.String("job", s.Job), slog.Any("error", err))
+				}
+			}
+		}
+	}
+
+	if f.terminal() {
+		f.State = f.result()
+		f.EndTime = time.Now()
+		changed = true
+		done = true
+		slog.Info("Workflow terminated", slog.Any("workflow", f.LogValue()))
+	}
+
+	if changed {
+		f.changed.Broadcast()
+	}
+
+	return
+}
+
+// startSteps starts the Jobs of the pending steps at the given indices.
+// f.m must be held by the caller. It is released while the Jobs are started, since
+// starting a Job waits for its sandbox to be set up; the steps stay Pending meanwhile.
+func (f *flowContext) startSteps(exe Executor, indices []int) {
+	if len(indices) == 0 {
+		return
+	}
+
+	jobs := make([]work.Job, len(indices))
+	for k, i := range indices {
+		jobs[k] = *work.NewJob(f.Owner, f.Steps[i].Cmd, slices.Clone(f.Steps[i].Args))
+	}
+
+	f.m.Unlock()
+	errs := make([]error, len(jobs))
+	for k := range jobs {
+		jobs[k], errs[k] = exe.Start(jobs[k])
+	}
+	f.m.Lock()
+
+	for k, i := range indices {
+		s := &f.Steps[i]
+		s.Job = jobs[k].Name
+		s.State = stepStateOf(jobs[k].State)
+		if errs[k] != nil {
+			slog.Error("Failed to start workflow step", slog.String("workflow", f.Name), slog.String("step", s.ID), slog.Any("error", errs[k]))
+			s.State = StepFailed
+		}
+	}
+}
+
+// evaluate the dependencies of s. ready is true if all dependencies
+// terminated and all conditions are met. skip is true if any condition
+// can no longer be met.
+func (f *flowContext) evaluate(s *Step) (ready, skip bool) {
+	ready = true
+	for _, d := range s.DependsOn {
+		dep := f.step(d.Step)
+		if !dep.Terminal() {
+			ready = false
+			continue
+		}
+		if d.Condition == OnSuccess && dep.State != StepCompleted {
+			skip = true
+		}
+	}
+	return ready && !skip, skip
+}
+
+// step finds a Step by ID. Validation guarantees that all IDs exist.
+func (f *flowContext) step(id string) *Step {
+	for i := range f.Steps {
+		if f.Steps[i].ID == id {
+			return &f.Steps[i]
+		}
+	}
+	return nil
+}
+
+// terminal is true if all steps terminated.
+func (f *flowContext) terminal() bool {
+	for _, s := range f.Steps {
+		if !s.Terminal() {
+			return false
+		}
+	}
+	return true
+}
+
+// result determines the final State once all steps terminated.
+func (f *flowContext) result() State {
+	if f.stopping {
+		return Stopped
+	}
+	for _, s := range f.Steps {
+		if s.State == StepFailed || s.State == StepStopped {
+			return Failed
+		}
+	}
+	return Completed
+}
@@ -0,0 +1,267 @@
+package workflow
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/safe"
+	"github.com/drrev/telehandler/pkg/work"
+	"github.com/drrev/telehandler/tests/utils"
+)
+
+// fakeExecutor runs no processes. Jobs stay running until finish is called.
+type fakeExecutor struct {
+	mu      sync.Mutex
+	jobs    map[string]work.Job
+	changed safe.Notifier
+	// starting is called by Start, if set, as if the sandbox of the Job were set up.
+	starting func(j work.Job)
+}
+
+func newFakeExecutor() *fakeExecutor {
+	return &fakeExecutor{jobs: make(map[string]work.Job)}
+}
+
+func (f *fakeExecutor) Start(j work.Job) (work.Job, error) {
+	if f.starting != nil {
+		f.starting(j)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	j.State = work.Running
+	f.jobs[j.Name] = j
+	f.changed.Broadcast()
+	return j, nil
+}
+
+func (f *fakeExecutor) Stop(name string) error {
+	f.finishJob(name, work.Stopped)
+	return nil
+}
+
+func (f *fakeExecutor) Lookup(name string) (work.Job, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.jobs[name], nil
+}
+
+func (f *fakeExecutor) Changes() <-chan struct{} {
+	return f.changed.Wait()
+}
+
+// finish terminates the running Job with the given command.
+func (f *fakeExecutor) finish(cmd string, state work.JobState) {
+	f.mu.Lock()
+	var name string
+	for _, j := range f.jobs {
+		if j.Cmd == cmd && j.State == work.Running {
+			name = j.Name
+		}
+	}
+	f.mu.Unlock()
+	f.finishJob(name, state)
+}
+
+func (f *fakeExecutor) finishJob(name string, state work.JobState) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	j := f.jobs[name]
+	j.State = state
+	f.jobs[name] = j
+	f.changed.Broadcast()
+}
+
+// waitFor blocks until the workflow satisfies cond.
+func waitFor(t *testing.T, m *Manager, name string, cond func(Workflow) bool) Workflow {
+	t.Helper()
+	deadline := time.After(2 * time.Second)
+	for {
+		ch, err := m.Changes(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w, _ := m.Lookup(name)
+		if cond(w) {
+			return w
+		}
+		select {
+		case <-ch:
+		case <-deadline:
+			t.Fatalf("workflow did not reach expected state, got %+v", w)
+		}
+	}
+}
+
+func stepStates(w Workflow) map[string]StepState {
+	states := make(map[string]StepState, len(w.Steps))
+	for _, s := range w.Steps {
+		states[s.ID] = s.State
+	}
+	return states
+}
+
+func TestWorkflow_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		steps   []Step
+		wantErr func(error) bool
+	}{
+		{
+			name:    "empty",
+			wantErr: utils.ErrorTextContains(t, "at least one step"),
+		},
+		{
+			name:    "invalid id",
+			steps:   []Step{{ID: "Build", Cmd: "make"}},
+			wantErr: utils.ErrorTextContains(t, "is invalid"),
+		},
+		{
+			name:    "duplicate id",
+			steps:   []Step{{ID: "build", Cmd: "make"}, {ID: "build", Cmd: "make"}},
+			wantErr: utils.ErrorTextContains(t, "not unique"),
+		},
+		{
+			name:    "missing command",
+			steps:   []Step{{ID: "build"}},
+			wantErr: utils.ErrorTextContains(t, "no command"),
+		},
+		{
+			name:    "unknown dependency",
+			steps:   []Step{{ID: "test", Cmd: "make", DependsOn: []Dependency{{"build", OnSuccess}}}},
+			wantErr: utils.ErrorTextContains(t, "unknown step"),
+		},
+		{
+			name:    "invalid condition",
+			steps:   []Step{{ID: "build", Cmd: "make"}, {ID: "test", Cmd: "make", DependsOn: []Dependency{{"build", ""}}}},
+			wantErr: utils.ErrorTextContains(t, "invalid condition"),
+		},
+		{
+			name: "cycle",
+			steps: []Step{
+				{ID: "a", Cmd: "true", DependsOn: []Dependency{{"c", OnSuccess}}},
+				{ID: "b", Cmd: "true", DependsOn: []Dependency{{"a", OnSuccess}}},
+				{ID: "c", Cmd: "true", DependsOn: []Dependency{{"b", Always}}},
+			},
+			wantErr: utils.ErrorTextContains(t, "cycle"),
+		},
+		{
+			name: "diamond",
+			steps: []Step{
+				{ID: "a", Cmd: "true"},
+				{ID: "b", Cmd: "true", DependsOn: []Dependency{{"a", OnSuccess}}},
+				{ID: "c", Cmd: "true", DependsOn: []Dependency{{"a", OnSuccess}}},
+				{ID: "d", Cmd: "true", DependsOn: []Dependency{{"b", OnSuccess}, {"c", Always}}},
+			},
+			wantErr: utils.NoError(t),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorkflow("users/test", tt.steps)
+			if err := w.Validate(); !tt.wantErr(err) {
+				t.Errorf("Workflow.Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestManager_Create(t *testing.T) {
+	t.Parallel()
+	exe := newFakeExecutor()
+	m := NewManager(exe)
+
+	w, err := m.Create(*NewWorkflow("users/test", []Step{
+		{ID: "build", Cmd: "build"},
+		{ID: "test", Cmd: "test", DependsOn: []Dependency{{"build", OnSuccess}}},
+		{ID: "package", Cmd: "package", DependsOn: []Dependency{{"test", OnSuccess}}},
+		{ID: "cleanup", Cmd: "cleanup", DependsOn: []Dependency{{"test", Always}}},
+	}))
+	if err != nil {
+		t.Fatalf("Manager.Create() error = %v", err)
+	}
+
+	if got := stepStates(w); got["build"] != StepRunning || got["test"] != Pending {
+		t.Errorf("Manager.Create() unexpected initial states %v", got)
+	}
+
+	exe.finish("build", work.Completed)
+	waitFor(t, m, w.Name, func(w Workflow) bool { return stepStates(w)["test"] == StepRunning })
+
+	exe.finish("test", work.Failed)
+	w = waitFor(t, m, w.Name, func(w Workflow) bool { return stepStates(w)["cleanup"] == StepRunning })
+	if got := stepStates(w)["package"]; got != Skipped {
+		t.Errorf("Manager.Create() package state = %v, want %v", got, Skipped)
+	}
+
+	exe.finish("cleanup", work.Completed)
+	w = waitFor(t, m, w.Name, func(w Workflow) bool { return !w.Running() })
+	if w.State != Failed {
+		t.Errorf("Manager.Create() workflow state = %v, want %v", w.State, Failed)
+	}
+	if w.EndTime.IsZero() {
+		t.Error("Manager.Create() workflow EndTime was not set")
+	}
+}
+
+func TestManager_Stop(t *testing.T) {
+	t.Parallel()
+	exe := newFakeExecutor()
+	m := NewManager(exe)
+
+	if err := m.Stop("missing"); !utils.ErrorTextContains(t, "no workflow found")(err) {
+		t.Errorf("Manager.Stop() error = %v", err)
+	}
+
+	w, err := m.Create(*NewWorkflow("users/test", []Step{
+		{ID: "build", Cmd: "build"},
+		{ID: "test", Cmd: "test", DependsOn: []Dependency{{"build", Always}}},
+	}))
+	if err != nil {
+		t.Fatalf("Manager.Create() error = %v", err)
+	}
+
+	if err := m.Stop(w.Name); err != nil {
+		t.Fatalf("Manager.Stop() error = %v", err)
+	}
+
+	w = waitFor(t, m, w.Name, func(w Workflow) bool { return !w.Running() })
+	if w.State != Stopped {
+		t.Errorf("Manager.Stop() workflow state = %v, want %v", w.State, Stopped)
+	}
+	if got := stepStates(w); got["build"] != StepStopped || got["test"] != Skipped {
+		t.Errorf("Manager.Stop() unexpected step states %v", got)
+	}
+
+	if err := m.Stop(w.Name); !utils.ErrorTextContains(t, "invalid state")(err) {
+		t.Errorf("Manager.Stop() error = %v", err)
+	}
+}
+
+func TestManager_LookupWhileStarting(t *testing.T) {
+	t.Parallel()
+	exe := newFakeExecutor()
+	m := NewManager(exe)
+
+	w := NewWorkflow("users/test", []Step{{ID: "build", Cmd: "build", Args: []string{"all"}}})
+	var got Workflow
+	var lookupErr error
+	exe.starting = func(work.Job) {
+		// the workflow must not be locked while its steps are started
+		got, lookupErr = m.Lookup(w.Name)
+	}
+
+	if _, err := m.Create(*w); err != nil {
+		t.Fatalf("Manager.Create() error = %v", err)
+	}
+	if lookupErr != nil || stepStates(got)["build"] != Pending {
+		t.Errorf("Manager.Lookup() while starting = %v, error = %v, want build %v", stepStates(got), lookupErr, Pending)
+	}
+
+	// a copy never shares the steps of the workflow
+	got.Steps[0].Args[0] = "changed"
+	if w, _ := m.Lookup(w.Name); w.Steps[0].Args[0] != "all" {
+		t.Errorf("Manager.Lookup() args = %v, want [all]", w.Steps[0].Args)
+	}
+}
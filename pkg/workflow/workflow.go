@@ -0,0 +1,230 @@
+package workflow
+
+import (
+	"fmt"
+	"log/slog"
+	"path"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/drrev/telehandler/pkg/work"
+	"github.com/google/uuid"
+)
+
+// State is used to demarcate where a [Workflow] is in its lifecycle.
+type State string
+
+const (
+	// The workflow has steps that have not terminated.
+	Running State = "WORKFLOW_STATE_RUNNING"
+	// All steps terminated and at least one step failed or was stopped.
+	Failed State = "WORKFLOW_STATE_FAILED"
+	// All steps ran to completion and exited successfully.
+	Completed State = "WORKFLOW_STATE_COMPLETED"
+	// The workflow was stopped by a user before completing execution.
+	Stopped State = "WORKFLOW_STATE_STOPPED"
+)
+
+// StepState is used to demarcate where a [Step] is in its lifecycle.
+type StepState string
+
+const (
+	// The step is waiting for its dependencies to terminate.
+	Pending StepState = "STEP_STATE_PENDING"
+	// The Job for this step is waiting for capacity.
+	Queued StepState = "STEP_STATE_QUEUED"
+	// The Job for this step is running.
+	StepRunning StepState = "STEP_STATE_RUNNING"
+	// The Job for this step failed.
+	StepFailed StepState = "STEP_STATE_FAILED"
+	// The Job for this step exited successfully.
+	StepCompleted StepState = "STEP_STATE_COMPLETED"
+	// The Job for this step was stopped.
+	StepStopped StepState = "STEP_STATE_STOPPED"
+	// The step will never run, since a dependency condition cannot be met.
+	Skipped StepState = "STEP_STATE_SKIPPED"
+)
+
+// Condition determines if a [Step] may run once a dependency terminates.
+type Condition string
+
+const (
+	// Run only if the dependency completed successfully.
+	// If the dependency did not complete, the step is [Skipped].
+	OnSuccess Condition = "CONDITION_SUCCESS"
+	// Run once the dependency terminates, regardless of outcome.
+	Always Condition = "CONDITION_ALWAYS"
+)
+
+// stepIDPattern restricts step IDs to a subset of AIP-122 resource IDs.
+var stepIDPattern = regexp.MustCompile(`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// Dependency of a [Step] on another Step within the same [Workflow].
+type Dependency struct {
+	// Step is the ID of the depended-on step.
+	Step string
+	// Condition under which the dependent step may run.
+	Condition Condition
+}
+
+// Step is a single node of a [Workflow].
+type Step struct {
+	// ID uniquely identifies the step within the Workflow.
+	ID string
+	// Cmd path to an executable to run for this Step.
+	Cmd string
+	// Args passed to the subprocess.
+	Args []string
+	// DependsOn lists all steps that must terminate before this step runs.
+	DependsOn []Dependency
+	// Job is the resource name of the [work.Job] started for this step.
+	// This field is empty until the step is started.
+	Job string
+	// State of the step.
+	State StepState
+}
+
+// Terminal is a convenience function to check if the [Step] will not change state.
+func (s *Step) Terminal() bool {
+	switch s.State {
+	case StepFailed, StepCompleted, StepStopped, Skipped:
+		return true
+	}
+	return false
+}
+
+// Workflow is a DAG of [Step] values.
+type Workflow struct {
+	Name string
+	// Owner that created this Workflow.
+	Owner string
+	// Steps of the Workflow. Order is not significant.
+	Steps []Step
+	State State
+	// CreateTime is the time the Workflow was created.
+	CreateTime time.Time
+	// EndTime is the time that the last step terminated.
+	// This field is only valid if State != Running.
+	EndTime time.Time
+}
+
+// NewWorkflow creates a [Workflow] with a randomly generated UUID
+// and the given owner and steps.
+func NewWorkflow(owner string, steps []Step) *Workflow {
+	return &Workflow{
+		Name:  path.Join(owner, "/workflows/", uuid.New().String()),
+		Owner: owner,
+		Steps: steps,
+	}
+}
+
+// Running is a convenience function to check
+// if the [Workflow] is [Running].
+func (w *Workflow) Running() bool {
+	return w.State == Running
+}
+
+// Validate checks that all steps are uniquely identified, all dependencies
+// exist, and there are no cycles.
+func (w *Workflow) Validate() error {
+	if len(w.Steps) == 0 {
+		return invalidWorkflow("at least one step is required")
+	}
+
+	index := make(map[string]int, len(w.Steps))
+	for i, s := range w.Steps {
+		if !stepIDPattern.MatchString(s.ID) {
+			return invalidWorkflow(fmt.Sprintf("step id '%s' is invalid", s.ID))
+		}
+		if _, ok := index[s.ID]; ok {
+			return invalidWorkflow(fmt.Sprintf("step id '%s' is not unique", s.ID))
+		}
+		if s.Cmd == "" {
+			return invalidWorkflow(fmt.Sprintf("step '%s' has no command", s.ID))
+		}
+		index[s.ID] = i
+	}
+
+	// Kahn's algorithm, any steps left over are part of a cycle
+	indegree := make([]int, len(w.Steps))
+	dependents := make([][]int, len(w.Steps))
+	for i, s := range w.Steps {
+		for _, d := range s.DependsOn {
+			j, ok := index[d.Step]
+			if !ok {
+				return invalidWorkflow(fmt.Sprintf("step '%s' depends on unknown step '%s'", s.ID, d.Step))
+			}
+			switch d.Condition {
+			case OnSuccess, Always:
+			default:
+				return invalidWorkflow(fmt.Sprintf("step '%s' has invalid condition '%s'", s.ID, d.Condition))
+			}
+			indegree[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	var ready []int
+	for i, n := range indegree {
+		if n == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	visited := 0
+	for len(ready) > 0 {
+		i := ready[0]
+		ready = ready[1:]
+		visited++
+		for _, j := range dependents[i] {
+			indegree[j]--
+			if indegree[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+
+	if visited != len(w.Steps) {
+		return invalidWorkflow("steps contain a dependency cycle")
+	}
+
+	return nil
+}
+
+// clone returns a deep copy of w that is safe to hand out.
+func (w Workflow) clone() Workflow {
+	w.Steps = slices.Clone(w.Steps)
+	for i := range w.Steps {
+		w.Steps[i].Args = slices.Clone(w.Steps[i].Args)
+		w.Steps[i].DependsOn = slices.Clone(w.Steps[i].DependsOn)
+	}
+	return w
+}
+
+// LogValue implements slog.LogValuer.
+func (w Workflow) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("owner", w.Owner),
+		slog.String("name", w.Name),
+		slog.String("state", string(w.State)),
+		slog.Int("steps", len(w.Steps)),
+	)
+}
+
+// stepStateOf maps a [work.JobState] onto a [StepState].
+func stepStateOf(s work.JobState) StepState {
+	switch s {
+	case work.Queued:
+		return Queued
+	case work.Running, work.Paused, work.Restarting:
+		return StepRunning
+	case work.Failed:
+		return StepFailed
+	case work.Completed:
+		return StepCompleted
+	case work.Stopped:
+		return StepStopped
+	}
+	return Pending
+}